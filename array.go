@@ -0,0 +1,42 @@
+package wayland
+
+import "encoding/binary"
+
+// Array is the raw content of a Wayland array argument
+type Array []byte
+
+// Uint32s interprets the array as a list of 32-bit unsigned integers, e.g. the keys of wl_keyboard.enter
+func (array Array) Uint32s() []uint32 {
+	result := make([]uint32, len(array)/4)
+	for i := range result {
+		result[i] = binary.LittleEndian.Uint32(array[i*4:])
+	}
+	return result
+}
+
+// Int32s interprets the array as a list of 32-bit signed integers
+func (array Array) Int32s() []int32 {
+	result := make([]int32, len(array)/4)
+	for i := range result {
+		result[i] = int32(binary.LittleEndian.Uint32(array[i*4:]))
+	}
+	return result
+}
+
+// Uint32Array creates an array from a list of 32-bit unsigned integers
+func Uint32Array(values ...uint32) Array {
+	result := make(Array, len(values)*4)
+	for i, value := range values {
+		binary.LittleEndian.PutUint32(result[i*4:], value)
+	}
+	return result
+}
+
+// Int32Array creates an array from a list of 32-bit signed integers
+func Int32Array(values ...int32) Array {
+	result := make(Array, len(values)*4)
+	for i, value := range values {
+		binary.LittleEndian.PutUint32(result[i*4:], uint32(value))
+	}
+	return result
+}
@@ -1,145 +1,632 @@
 package wayland
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"os"
-	"path/filepath"
+	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"golang.org/x/sys/unix"
 )
 
+// maxFds is the maximum number of file descriptors that can be sent or received along with a single sendmsg or
+// recvmsg call, which is the limit used by libwayland
+const maxFds = 28
+
+// ServerIdStart is the first object ID of the range allocated by the compositor for objects it creates, everything
+// below it is allocated by the client
+const ServerIdStart = 0xff000000
+
 type Client struct {
-	conn      net.Conn
-	objectId  uint32
+	conn      *net.UnixConn
 	rmu       sync.Mutex
+	in        []byte
+	inBuf     []byte
+	oob       []byte
+	fds       []int
+	wmu       sync.Mutex
+	out       []byte
+	outFds    []int
+	outSize   int
+	omu       sync.Mutex
+	objectId  uint32
+	freeIds   []uint32
+	objects   map[uint32]*Interface
+	zombies   map[uint32]bool
+	onFree    func(objectId uint32)
+	onDestroy func(objectId uint32)
+	onNew     func(objectId uint32, iface string, parentId uint32)
+	lmu       sync.RWMutex
 	listeners map[int]map[int]func(message *Message)
+	tracer    atomic.Pointer[slog.Logger]
+	logger    *slog.Logger
+	bufSize   int
+	// dispatching holds a token while a goroutine reads and delivers a message, so events are delivered in order by
+	// one goroutine at a time, and delivering counts the listeners that are running, including nested ones
+	dispatching chan struct{}
+	delivering  atomic.Int32
+	// closed is closed once reading failed, e.g. with a protocol error, after err has been set
+	closed    chan struct{}
+	closeOnce sync.Once
+	err       error
 }
 
-// NewObjectId returns a new object ID that hasn't been used yet
+// NewObjectId returns a client-side object ID that isn't in use, reusing IDs the compositor has released through
+// wl_display.delete_id
 func (client *Client) NewObjectId() uint32 {
+	client.omu.Lock()
+	defer client.omu.Unlock()
+
+	if n := len(client.freeIds); n > 0 {
+		id := client.freeIds[n-1]
+		client.freeIds = client.freeIds[:n-1]
+		return id
+	}
+
 	client.objectId++
 	return client.objectId
 }
 
-// Read waits for and reads the next message from the compositor
-func (client *Client) Read() *Message {
-	// TODO: read fds
+// FreeObjectId forgets everything about an object and its listeners. Client-side IDs are made available to
+// NewObjectId again, server-side IDs are left to the compositor to reuse.
+func (client *Client) FreeObjectId(objectId uint32) {
+	client.lmu.Lock()
+	delete(client.listeners, int(objectId))
+	client.lmu.Unlock()
+
+	client.omu.Lock()
+	defer client.omu.Unlock()
+
+	delete(client.objects, objectId)
+	delete(client.zombies, objectId)
+	if client.onFree != nil {
+		client.onFree(objectId)
+	}
+
+	// Only IDs handed out by NewObjectId can be reused, and each of them only once
+	if objectId >= ServerIdStart || objectId > client.objectId || objectId == displayObjectId || slices.Contains(client.freeIds, objectId) {
+		return
+	}
+	client.freeIds = append(client.freeIds, objectId)
+}
+
+// OnFreeObjectId calls listener whenever an object ID is freed, so data associated with the object can be released.
+// The listener must not call back into the client.
+func (client *Client) OnFreeObjectId(listener func(objectId uint32)) {
+	client.omu.Lock()
+	client.onFree = listener
+	client.omu.Unlock()
+}
+
+// DestroyObject is called when an object is destroyed by a destructor request or event, and removes its listeners.
+// Client-side IDs stay reserved as zombies until the compositor confirms the destruction through wl_display.delete_id,
+// and events the compositor sent to them in the meantime are discarded, closing any file descriptors they carry.
+// Server-side IDs are freed right away, as the compositor doesn't confirm their destruction.
+func (client *Client) DestroyObject(objectId uint32) {
+	if objectId >= ServerIdStart {
+		client.omu.Lock()
+		if client.onDestroy != nil {
+			client.onDestroy(objectId)
+		}
+		client.omu.Unlock()
+
+		client.FreeObjectId(objectId)
+		return
+	}
+
+	client.zombify(objectId)
+}
+
+// zombify destroys an object, but keeps its interface until its ID is freed, so the file descriptors of events sent
+// to it in the meantime can be closed
+func (client *Client) zombify(objectId uint32) {
+	client.lmu.Lock()
+	delete(client.listeners, int(objectId))
+	client.lmu.Unlock()
 
+	client.omu.Lock()
+	defer client.omu.Unlock()
+
+	client.zombies[objectId] = true
+	if client.onDestroy != nil {
+		client.onDestroy(objectId)
+	}
+}
+
+// OnDestroyObject calls listener whenever an object is destroyed, before its ID is freed. The listener must not call
+// back into the client.
+func (client *Client) OnDestroyObject(listener func(objectId uint32)) {
+	client.omu.Lock()
+	client.onDestroy = listener
+	client.omu.Unlock()
+}
+
+// OnNewObject calls listener whenever the compositor creates an object through a new_id argument of an event, with
+// the interface named by the event and the ID of the object the event was sent to. The listener is called before the
+// event is delivered, whether or not a listener is registered for it, so the new object can be set up before any of
+// its own events arrive. Unlike the listeners of OnFreeObjectId and OnDestroyObject, it may call back into the client.
+func (client *Client) OnNewObject(listener func(objectId uint32, iface string, parentId uint32)) {
+	client.omu.Lock()
+	client.onNew = listener
+	client.omu.Unlock()
+}
+
+// zombie reports whether the object with the specified objectId has been destroyed, but its ID not yet freed
+func (client *Client) zombie(objectId uint32) bool {
+	client.omu.Lock()
+	defer client.omu.Unlock()
+
+	return client.zombies[objectId]
+}
+
+// SetInterface records the interface of an object, which is needed to decode the events it receives
+func (client *Client) SetInterface(objectId uint32, iface *Interface) {
+	client.omu.Lock()
+	client.objects[objectId] = iface
+	client.omu.Unlock()
+}
+
+// objectInterface returns the interface of the object with the specified objectId, or nil if it isn't known
+func (client *Client) objectInterface(objectId uint32) *Interface {
+	client.omu.Lock()
+	defer client.omu.Unlock()
+
+	return client.objects[objectId]
+}
+
+// event returns the description of the event with the specified objectId and opcode, if the object's interface is
+// known
+func (client *Client) event(objectId uint32, opcode uint16) (Method, bool) {
+	iface := client.objectInterface(objectId)
+	if iface == nil || int(opcode) >= len(iface.Events) {
+		return Method{}, false
+	}
+	return iface.Events[opcode], true
+}
+
+// eventFds returns the number of file descriptors carried by the event with the specified objectId and opcode
+func (client *Client) eventFds(objectId uint32, opcode uint16) int {
+	event, _ := client.event(objectId, opcode)
+	return event.Fds()
+}
+
+// fill reads from the socket until at least n bytes are buffered, queueing any file descriptors passed along. Buffered
+// requests are flushed before blocking, as the compositor might not send anything before receiving them.
+func (client *Client) fill(n int) error {
+	for len(client.in) < n {
+		if err := client.Flush(); err != nil {
+			return err
+		}
+
+		// Move the unread bytes to the front of the buffer once there is no room to read behind them
+		if cap(client.in)-len(client.in) < client.bufSize {
+			client.inBuf = slices.Grow(client.inBuf[:0], len(client.in)+client.bufSize)
+			client.in = client.inBuf[:copy(client.inBuf[:len(client.in)], client.in)]
+		}
+		if client.oob == nil {
+			client.oob = make([]byte, unix.CmsgSpace(maxFds*4))
+		}
+
+		bn, oobn, flags, _, err := client.conn.ReadMsgUnix(client.in[len(client.in):len(client.in)+client.bufSize], client.oob)
+		if err != nil {
+			return err
+		} else if bn == 0 {
+			return io.EOF
+		}
+		if flags&unix.MSG_CTRUNC != 0 {
+			// The file descriptors that didn't fit were closed by the kernel, so messages can't be matched with theirs
+			client.closeControlMessage(oobn)
+			return fmt.Errorf("received more than %d file descriptors at once, some of which were discarded", maxFds)
+		}
+
+		if oobn > 0 {
+			scms, err := unix.ParseSocketControlMessage(client.oob[:oobn])
+			if err != nil {
+				return err
+			}
+
+			for _, scm := range scms {
+				fds, err := unix.ParseUnixRights(&scm)
+				if err != nil {
+					client.logger.Warn("ignored socket control message", "error", err)
+					continue
+				}
+				client.fds = append(client.fds, fds...)
+			}
+		}
+		client.in = client.in[:len(client.in)+bn]
+	}
+
+	return nil
+}
+
+// closeControlMessage closes the file descriptors of the first oobn bytes of control messages that were received
+func (client *Client) closeControlMessage(oobn int) {
+	scms, _ := unix.ParseSocketControlMessage(client.oob[:oobn])
+	for _, scm := range scms {
+		fds, _ := unix.ParseUnixRights(&scm)
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+	}
+}
+
+// Read waits for and reads the next message from the compositor, along with any file descriptors passed through. The
+// number of file descriptors of an event is taken from the interface of its object, so Read fails rather than passing
+// file descriptors along with the wrong message if they were received while the interface is unknown, or if the
+// kernel discarded some of them.
+func (client *Client) Read() (*Message, error) {
 	client.rmu.Lock()
+	defer client.rmu.Unlock()
 
-	header := make([]byte, 8)
-	if _, err := io.ReadFull(client.conn, header); err != nil {
-		client.rmu.Unlock()
-		fmt.Printf("error reading from socket: %v\n", err)
-		return nil
+	if err := client.fill(8); err != nil {
+		return nil, fmt.Errorf("unable to read message header: %w", err)
 	}
 
-	size := binary.LittleEndian.Uint16(header[6:8])
+	size := binary.LittleEndian.Uint16(client.in[6:8])
+	if size < 8 || size%4 != 0 {
+		// Every argument is padded to 32 bits, so a valid size is a multiple of 4 and covers at least the header
+		return nil, &MalformedMessageError{
+			ObjectId: binary.LittleEndian.Uint32(client.in[0:4]),
+			OpCode:   binary.LittleEndian.Uint16(client.in[4:6]),
+			Reason:   fmt.Sprintf("invalid size %d", size),
+		}
+	}
 
-	body := make([]byte, size-8)
-	if _, err := io.ReadFull(client.conn, body); err != nil {
-		client.rmu.Unlock()
-		fmt.Printf("error reading message body: %v\n", err)
-		return nil
+	if err := client.fill(int(size)); err != nil {
+		return nil, fmt.Errorf("unable to read message body: %w", err)
 	}
 
-	client.rmu.Unlock()
+	result := newMessage(binary.LittleEndian.Uint32(client.in[0:4]), binary.LittleEndian.Uint16(client.in[4:6]))
+	result.Size = size
+	result.Body = append(result.Body, client.in[8:size]...)
+	client.in = client.in[size:]
 
-	result := &Message{
-		ObjectId: binary.LittleEndian.Uint32(header[0:4]),
-		Size:     size,
-		OpCode:   binary.LittleEndian.Uint16(header[4:6]),
-		Body:     body,
+	// File descriptors are queued in the order of the messages they belong to, so they can't be matched with the
+	// messages following an event whose arguments are unknown
+	if _, ok := client.event(result.ObjectId, result.OpCode); !ok && len(client.fds) > 0 {
+		err := fmt.Errorf("unable to match %d received file descriptors with event %d of object %d, whose interface is unknown", len(client.fds), result.OpCode, result.ObjectId)
+		result.Release()
+		return nil, err
+	}
+	if n := min(client.eventFds(result.ObjectId, result.OpCode), len(client.fds)); n > 0 {
+		result.Fds = append([]int(nil), client.fds[:n]...)
+		client.fds = client.fds[:copy(client.fds, client.fds[n:])]
 	}
-	//fmt.Println("<<< " + result.String())
-	return result
+
+	client.trace(result, true)
+	return result, nil
 }
 
-// Write sends a message to the compositor, optionally passing through any file descriptors
+// Write queues a message to be sent to the compositor, optionally passing through any file descriptors. Messages are
+// buffered until Flush is called, the buffer is full or the client is about to wait for events. The file descriptors
+// are duplicated, so the caller can close them right away. The message is released once it has been encoded, and must
+// not be used afterwards.
 func (client *Client) Write(msg *Message) error {
-	//fmt.Println(">>> " + msg.String())
-	if len(msg.Fds) == 0 {
-		_, err := client.conn.Write(msg.Bytes())
-		return err
-	} else {
-		_, _, err := client.conn.(*net.UnixConn).WriteMsgUnix(msg.Bytes(), unix.UnixRights(msg.Fds...), nil)
-		return err
+	if msg == nil {
+		return errors.New("unable to encode message, an argument is of an unsupported type or the message is too large")
+	}
+	defer msg.Release()
+
+	if 8+len(msg.Body) > maxMessageSize {
+		return fmt.Errorf("message of %d bytes exceeds the maximum size of %d bytes", 8+len(msg.Body), maxMessageSize)
 	}
+
+	client.trace(msg, false)
+
+	client.wmu.Lock()
+	defer client.wmu.Unlock()
+
+	if len(client.out)+8+len(msg.Body) > client.outSize || len(client.outFds)+len(msg.Fds) > maxFds {
+		if err := client.flush(); err != nil {
+			return err
+		}
+	}
+
+	for _, fd := range msg.Fds {
+		dup, err := unix.FcntlInt(uintptr(fd), unix.F_DUPFD_CLOEXEC, 0)
+		if err != nil {
+			return fmt.Errorf("unable to duplicate file descriptor %d: %w", fd, err)
+		}
+		client.outFds = append(client.outFds, dup)
+	}
+	client.out = msg.AppendBytes(client.out)
+	return nil
+}
+
+// Flush sends all buffered messages to the compositor
+func (client *Client) Flush() error {
+	client.wmu.Lock()
+	defer client.wmu.Unlock()
+
+	return client.flush()
+}
+
+// flush sends all buffered messages with a single sendmsg call if possible. The socket is non-blocking, so if its
+// buffer is full, the runtime waits until it is writable again instead of failing with EAGAIN. File descriptors are
+// passed along with the first part of the data, which the compositor receives before the messages they belong to.
+func (client *Client) flush() error {
+	if len(client.out) == 0 {
+		return nil
+	}
+
+	defer func() {
+		for _, fd := range client.outFds {
+			unix.Close(fd)
+		}
+		client.out = client.out[:0]
+		client.outFds = client.outFds[:0]
+	}()
+
+	var oob []byte
+	if len(client.outFds) > 0 {
+		oob = unix.UnixRights(client.outFds...)
+	}
+
+	n, _, err := client.conn.WriteMsgUnix(client.out, oob, nil)
+	if err == nil && n < len(client.out) {
+		// Stream sockets can accept only part of the data, Write keeps writing until everything has been sent
+		_, err = client.conn.Write(client.out[n:])
+	}
+	return err
 }
 
 // Request composes a message and sends it as request to the compositor
-func (client *Client) Request(objectId uint32, opcode uint16, args ...any) {
-	client.Write(NewMessage(objectId, opcode, args...))
+func (client *Client) Request(objectId uint32, opcode uint16, args ...any) error {
+	return client.Write(NewMessage(objectId, opcode, args...))
 }
 
-// On calls listener if the client receives an event with the specified objectId and opcode
+// On calls listener if the client receives an event with the specified objectId and opcode, replacing any listener
+// previously registered for it. It is safe to call On concurrently with Listen, including from within a listener. The
+// message is released once the listener returns, so it must not be retained.
 func (client *Client) On(objectId uint32, opcode uint16, listener func(message *Message)) chan struct{} {
-	wait := make(chan struct{})
+	wait := make(chan struct{}, 1)
+
+	client.on(objectId, opcode, func(msg *Message) {
+		listener(msg)
+
+		// Events received while a previous one hasn't been awaited yet don't queue up further notifications
+		select {
+		case wait <- struct{}{}:
+		default:
+		}
+	})
+
+	return wait
+}
+
+// on registers a listener like On, without a channel to wait for the event with
+func (client *Client) on(objectId uint32, opcode uint16, listener func(message *Message)) {
+	client.lmu.Lock()
+	defer client.lmu.Unlock()
 
 	listeners, ok := client.listeners[int(objectId)]
 	if !ok {
 		client.listeners[int(objectId)] = make(map[int]func(message *Message))
 		listeners = client.listeners[int(objectId)]
 	}
-	listeners[int(opcode)] = func(msg *Message) {
-		listener(msg)
+	listeners[int(opcode)] = listener
+}
 
-		if len(wait) == 0 {
-			go func() {
-				wait <- struct{}{}
-			}()
-		}
-	}
+// listener returns the listener registered for the specified objectId and opcode, or nil if there is none
+func (client *Client) listener(objectId uint32, opcode uint16) func(message *Message) {
+	client.lmu.RLock()
+	defer client.lmu.RUnlock()
 
-	return wait
+	return client.listeners[int(objectId)][int(opcode)]
 }
 
-// Listen reads and delivering messages to the appropriate listener if registered
-func (client *Client) Listen() {
+// Listen reads and delivers messages to the appropriate listener if registered, until the connection is closed, the
+// compositor reports a protocol error or a message is malformed. Events of destroyed objects are discarded, and
+// destructor events destroy their object after they have been delivered.
+func (client *Client) Listen() error {
 	for {
-		msg := client.Read()
-		if listeners, ok := client.listeners[int(msg.ObjectId)]; ok {
-			if listener, ok := listeners[int(msg.OpCode)]; ok {
-				listener(msg)
-			}
+		client.dispatching <- struct{}{}
+		err := client.dispatch()
+		<-client.dispatching
+
+		if err != nil {
+			return err
 		}
 	}
 }
 
-// Close disconnects the client
-func (client *Client) Close() {
-	client.conn.Close()
+// dispatch reads and delivers a single message. Errors other than an expired read deadline end the connection, and
+// are reported to Roundtrip.
+func (client *Client) dispatch() error {
+	err := client.dispatchMessage()
+	if err != nil && !errors.Is(err, os.ErrDeadlineExceeded) {
+		client.closeOnce.Do(func() {
+			client.err = err
+			close(client.closed)
+		})
+	}
+	return err
 }
 
-// NewClient creates a new client and tries to connect to the compositor
-func NewClient() (*Client, error) {
-	address := os.Getenv("WAYLAND_SOCKET")
-	if address == "" {
-		waylandDisplay := os.Getenv("WAYLAND_DISPLAY")
-		xdgRuntimeDir := os.Getenv("XDG_RUNTIME_DIR")
-		if waylandDisplay != "" && xdgRuntimeDir != "" {
-			address = filepath.Join(xdgRuntimeDir, waylandDisplay)
-		} else if xdgRuntimeDir != "" {
-			address = filepath.Join(xdgRuntimeDir, "wayland-0")
-		} else {
-			return nil, errors.New("no Wayland compositor detected")
+// newObjects registers the objects created by the new_id arguments of an event with the listener of OnNewObject. IDs
+// of destroyed objects are reused by the new ones.
+func (client *Client) newObjects(parentId uint32, event Method, newIds []uint32) {
+	if len(newIds) == 0 {
+		return
+	}
+
+	client.omu.Lock()
+	onNew := client.onNew
+	for _, id := range newIds {
+		delete(client.zombies, id)
+	}
+	client.omu.Unlock()
+
+	for i, id := range newIds {
+		if onNew != nil && i < len(event.NewInterfaces) {
+			onNew(id, event.NewInterfaces[i], parentId)
 		}
 	}
+}
 
-	result := &Client{
-		listeners: make(map[int]map[int]func(message *Message)),
+// dispatchMessage reads a message and delivers it to its listener
+func (client *Client) dispatchMessage() error {
+	msg, err := client.Read()
+	if err != nil {
+		return err
+	}
+	defer msg.Release()
+
+	// Listeners of known events only get to decode arguments that are valid
+	event, _ := client.event(msg.ObjectId, msg.OpCode)
+	newIds, err := msg.validate(event.Signature)
+
+	if client.zombie(msg.ObjectId) {
+		// Objects created by the event are destroyed right away, but like their parent, they keep their interface to
+		// discard the events the compositor sends them before learning about it
+		if err == nil {
+			client.newObjects(msg.ObjectId, event, newIds)
+			for _, id := range newIds {
+				client.zombify(id)
+			}
+		}
+
+		client.logger.Debug("discarded event of destroyed object", "object", msg.ObjectId, "opcode", msg.OpCode, "fds", len(msg.Fds))
+		for _, fd := range msg.Fds {
+			unix.Close(fd)
+		}
+		return nil
 	}
 
-	var err error
-	result.conn, err = net.Dial("unix", address)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	client.newObjects(msg.ObjectId, event, newIds)
 
-	return result, nil
+	var protocolErr *ProtocolError
+	var deletedId uint32
+	if msg.ObjectId == displayObjectId && msg.OpCode == displayErrorOpCode {
+		if protocolErr, err = newProtocolError(msg, client.objectInterface); err != nil {
+			return err
+		}
+	} else if msg.ObjectId == displayObjectId && msg.OpCode == displayDeleteIdOpCode {
+		args := &Message{ObjectId: msg.ObjectId, OpCode: msg.OpCode, Body: msg.Body}
+		if deletedId = args.ReadUint32(); args.Err() != nil {
+			return args.Err()
+		}
+	}
+
+	if listener := client.listener(msg.ObjectId, msg.OpCode); listener != nil {
+		client.delivering.Add(1)
+		listener(msg)
+		client.delivering.Add(-1)
+		if err := msg.Err(); err != nil {
+			return err
+		}
+	}
+	if event.Destructor {
+		client.DestroyObject(msg.ObjectId)
+	}
+
+	if protocolErr != nil {
+		return protocolErr
+	} else if deletedId != 0 {
+		client.FreeObjectId(deletedId)
+	}
+	return nil
+}
+
+// Roundtrip blocks until the compositor has processed all requests sent so far, by sending wl_display.sync and
+// waiting for its done event. Events received in the meantime are delivered in order by whichever of Listen and
+// Roundtrip reads them, one message at a time. Listeners may call Roundtrip as well. While a listener is running,
+// Roundtrip delivers the following events itself, since the goroutine that would deliver them waits for the listener
+// to return, so a Roundtrip of another goroutine may then deliver events concurrently with that listener. Roundtrip
+// returns early if the connection fails, e.g. with a *ProtocolError, or with the error of ctx once it is done.
+func (client *Client) Roundtrip(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	callback := client.NewObjectId()
+	client.SetInterface(callback, callbackInterface)
+
+	done := make(chan struct{})
+	client.on(callback, 0, func(message *Message) {
+		close(done)
+	})
+	if err := client.Request(displayObjectId, displaySyncOpCode, callback); err != nil {
+		return err
+	}
+	if err := client.Flush(); err != nil {
+		return err
+	}
+
+	for {
+		inListener := client.delivering.Load() > 0
+		if !inListener {
+			select {
+			case client.dispatching <- struct{}{}:
+			case <-done:
+				return nil
+			case <-client.closed:
+				return client.err
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		// Another goroutine may have delivered the done event while waiting for the token
+		select {
+		case <-done:
+			if !inListener {
+				<-client.dispatching
+			}
+			return nil
+		default:
+		}
+
+		err := client.dispatchContext(ctx)
+		if !inListener {
+			<-client.dispatching
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dispatchContext reads and delivers a single message like dispatch, interrupting reading once ctx is done, in which
+// case it returns the error of ctx
+func (client *Client) dispatchContext(ctx context.Context) error {
+	interrupted := make(chan struct{})
+	stop := context.AfterFunc(ctx, func() {
+		client.conn.SetReadDeadline(time.Now())
+		close(interrupted)
+	})
+
+	err := client.dispatch()
+	for errors.Is(err, os.ErrDeadlineExceeded) && ctx.Err() == nil {
+		// The deadline interrupts a Roundtrip whose listener called this one, which can't return before the listener
+		// anyway, so reading continues until this Roundtrip is done
+		client.conn.SetReadDeadline(time.Time{})
+		err = client.dispatch()
+	}
+	if !stop() {
+		<-interrupted
+		client.conn.SetReadDeadline(time.Time{})
+	}
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// Close sends any buffered messages and disconnects the client
+func (client *Client) Close() {
+	client.Flush()
+	client.conn.Close()
 }
@@ -0,0 +1,849 @@
+package wayland
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// newTestClient creates a client connected to one end of a socket pair, returning the other end for the test to act
+// as the compositor
+func newTestClient(t testing.TB) (*Client, *Client) {
+	t.Helper()
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewClientFromFd(fds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := NewClientFromFd(fds[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+
+	return client, server
+}
+
+func TestOnConcurrentWithListen(t *testing.T) {
+	client, server := newTestClient(t)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.Listen()
+	}()
+
+	const objects = 50
+	const events = 20
+
+	var received atomic.Int32
+	var wg sync.WaitGroup
+	for id := uint32(2); id < objects+2; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for opcode := uint16(0); opcode < events; opcode++ {
+				client.On(id, opcode, func(message *Message) {
+					received.Add(1)
+				})
+			}
+		}()
+	}
+
+	go func() {
+		for id := uint32(2); id < objects+2; id++ {
+			for opcode := uint16(0); opcode < events; opcode++ {
+				server.Write(NewMessage(id, opcode, uint32(0)))
+			}
+		}
+		server.Flush()
+	}()
+
+	wg.Wait()
+
+	// Every listener is registered now, so one more round of events must all be delivered
+	for id := uint32(2); id < objects+2; id++ {
+		for opcode := uint16(0); opcode < events; opcode++ {
+			if err := server.Write(NewMessage(id, opcode, uint32(0))); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := server.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for received.Load() < objects*events {
+		if time.Now().After(deadline) {
+			t.Fatalf("received %d events, expected at least %d", received.Load(), objects*events)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	server.Close()
+	if err := <-done; err == nil {
+		t.Fatal("Listen returned without an error after the connection was closed")
+	}
+}
+
+func TestOnReplaceFromListener(t *testing.T) {
+	client, server := newTestClient(t)
+
+	first := make(chan struct{}, 2)
+	second := make(chan struct{}, 2)
+
+	client.On(2, 0, func(message *Message) {
+		first <- struct{}{}
+		client.On(2, 0, func(message *Message) {
+			second <- struct{}{}
+		})
+	})
+
+	go client.Listen()
+
+	for range 2 {
+		if err := server.Write(NewMessage(2, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := server.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ch := range []chan struct{}{first, second} {
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatal("listener was not called")
+		}
+	}
+
+	if len(first) != 0 {
+		t.Fatal("replaced listener was called again")
+	}
+}
+
+func TestDeleteIdReusesObjectId(t *testing.T) {
+	client, server := newTestClient(t)
+
+	if id := client.NewObjectId(); id != displayObjectId {
+		t.Fatalf("first object ID is %d, expected the display", id)
+	}
+	callback := client.NewObjectId()
+	other := client.NewObjectId()
+
+	done := make(chan struct{})
+	client.On(callback, 0, func(message *Message) {
+		t.Error("listener of a deleted object was called")
+	})
+	client.On(other, 0, func(message *Message) {
+		close(done)
+	})
+
+	go client.Listen()
+
+	// Server-side IDs and IDs that were never allocated must not be handed out
+	for _, id := range []uint32{callback, ServerIdStart, other + 100} {
+		if err := server.Write(NewMessage(displayObjectId, displayDeleteIdOpCode, id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.Write(NewMessage(callback, 0))
+	server.Write(NewMessage(other, 0))
+	server.Flush()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("events were not delivered")
+	}
+
+	if id := client.NewObjectId(); id != callback {
+		t.Fatalf("got object ID %d, expected deleted ID %d to be reused", id, callback)
+	}
+	if id := client.NewObjectId(); id != other+1 {
+		t.Fatalf("got object ID %d, expected %d", id, other+1)
+	}
+}
+
+func TestDestroyedObjectDiscardsEvents(t *testing.T) {
+	client, server := newTestClient(t)
+
+	client.NewObjectId()
+	buffer := client.NewObjectId()
+	other := client.NewObjectId()
+	client.SetInterface(buffer, &Interface{Name: "test_buffer", Events: []Method{{Name: "fd", Signature: "h"}}})
+
+	client.On(buffer, 0, func(message *Message) {
+		t.Error("listener of a destroyed object was called")
+	})
+	done := make(chan struct{})
+	client.On(other, 0, func(message *Message) {
+		close(done)
+	})
+
+	client.DestroyObject(buffer)
+	if id := client.NewObjectId(); id == buffer {
+		t.Fatal("ID of a destroyed object was reused before delete_id")
+	}
+
+	go client.Listen()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if err := server.Write(NewMessage(buffer, 0).WithFds(int(w.Fd()))); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	server.Write(NewMessage(displayObjectId, displayDeleteIdOpCode, buffer))
+	server.Write(NewMessage(other, 0))
+	server.Flush()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("events were not delivered")
+	}
+
+	// The pipe only reaches EOF once the client closed the file descriptor passed along with the discarded event
+	r.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err == nil || os.IsTimeout(err) {
+		t.Fatalf("file descriptor of a discarded event was not closed: %v", err)
+	}
+
+	if id := client.NewObjectId(); id != buffer {
+		t.Fatalf("got object ID %d, expected ID %d of the destroyed object to be reused", id, buffer)
+	}
+}
+
+func TestDestructorEvent(t *testing.T) {
+	client, server := newTestClient(t)
+
+	client.NewObjectId()
+	callback := client.NewObjectId()
+	other := client.NewObjectId()
+	client.SetInterface(callback, &Interface{Name: "test_callback", Events: []Method{{Name: "done", Signature: "u", Destructor: true}}})
+
+	var destroyed atomic.Uint32
+	client.OnDestroyObject(func(objectId uint32) {
+		destroyed.Store(objectId)
+	})
+
+	var called atomic.Int32
+	client.On(callback, 0, func(message *Message) {
+		called.Add(1)
+	})
+	done := make(chan struct{})
+	client.On(other, 0, func(message *Message) {
+		close(done)
+	})
+
+	go client.Listen()
+
+	for range 2 {
+		if err := server.Write(NewMessage(callback, 0, uint32(0))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.Write(NewMessage(other, 0))
+	server.Flush()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("events were not delivered")
+	}
+
+	if destroyed.Load() != callback {
+		t.Fatal("object was not destroyed by its destructor event")
+	}
+	if n := called.Load(); n != 1 {
+		t.Fatalf("listener was called %d times, expected once before the object was destroyed", n)
+	}
+}
+
+func TestNewObjectWithoutListener(t *testing.T) {
+	client, server := newTestClient(t)
+	client.NewObjectId()
+	device := client.NewObjectId()
+	client.SetInterface(device, &Interface{Name: "wl_data_device", Events: []Method{{Name: "data_offer", Signature: "n", NewInterfaces: []string{"wl_data_offer"}}}})
+
+	offerInterface := &Interface{Name: "wl_data_offer", Events: []Method{{Name: "offer", Signature: "s"}, {Name: "source", Signature: "h"}}}
+	var parent atomic.Uint32
+	client.OnNewObject(func(objectId uint32, iface string, parentId uint32) {
+		if iface == offerInterface.Name {
+			parent.Store(parentId)
+			client.SetInterface(objectId, offerInterface)
+		}
+	})
+
+	// Only the object created by the event has a listener, which needs its interface to receive the fd
+	offer := uint32(ServerIdStart)
+	done := make(chan int)
+	client.On(offer, 1, func(message *Message) {
+		done <- message.ReadFd()
+	})
+	go client.Listen()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	server.Write(NewMessage(device, 0, offer))
+	server.Write(NewMessage(offer, 1).WithFds(int(w.Fd())))
+	server.Flush()
+
+	select {
+	case fd := <-done:
+		if fd < 0 {
+			t.Fatal("file descriptor of the new object's event was not received")
+		}
+		unix.Close(fd)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event of the new object was not delivered")
+	}
+	if parent.Load() != device {
+		t.Fatalf("new object was created by object %d, expected %d", parent.Load(), device)
+	}
+}
+
+func TestDestroyedObjectDestroysNewObjects(t *testing.T) {
+	client, server := newTestClient(t)
+	client.NewObjectId()
+	device := client.NewObjectId()
+	other := client.NewObjectId()
+	deviceInterface := &Interface{Name: "wl_data_device", Events: []Method{{Name: "data_offer", Signature: "n", NewInterfaces: []string{"wl_data_offer"}}}}
+	client.SetInterface(device, deviceInterface)
+	client.SetInterface(other, deviceInterface)
+
+	offerInterface := &Interface{Name: "wl_data_offer", Events: []Method{{Name: "offer", Signature: "s"}, {Name: "source", Signature: "h"}}}
+	done := make(chan struct{})
+	client.OnNewObject(func(objectId uint32, iface string, parentId uint32) {
+		client.SetInterface(objectId, offerInterface)
+		if parentId == other {
+			client.On(objectId, 0, func(message *Message) {
+				close(done)
+			})
+		}
+	})
+
+	offer := uint32(ServerIdStart)
+	client.DestroyObject(device)
+	go client.Listen()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	// The offer created by the destroyed device is destroyed as well, and the compositor reuses its ID afterwards
+	server.Write(NewMessage(device, 0, offer))
+	server.Write(NewMessage(offer, 0, "ignored"))
+	server.Write(NewMessage(offer, 1).WithFds(int(w.Fd())))
+	w.Close()
+	server.Write(NewMessage(other, 0, offer))
+	server.Write(NewMessage(offer, 0, "text/plain"))
+	server.Flush()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event of the object reusing the ID was not delivered")
+	}
+
+	// The pipe only reaches EOF once the client closed the file descriptor passed along with the discarded event
+	r.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if n, err := r.Read(make([]byte, 1)); n != 0 || err == nil || os.IsTimeout(err) {
+		t.Fatalf("file descriptor of a discarded event was not closed: %v", err)
+	}
+}
+
+func TestListenReturnsProtocolError(t *testing.T) {
+	client, server := newTestClient(t)
+
+	client.NewObjectId()
+	surface := client.NewObjectId()
+	client.SetInterface(surface, &Interface{
+		Name:  "xdg_surface",
+		Enums: []Enum{{Name: "error", Entries: []EnumEntry{{Name: "not_constructed", Value: 1}}}},
+	})
+
+	if err := server.Write(NewMessage(displayObjectId, displayErrorOpCode, surface, uint32(1), "surface not constructed")); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	var protocolErr *ProtocolError
+	if err := client.Listen(); !errors.As(err, &protocolErr) {
+		t.Fatalf("Listen returned %v, expected a protocol error", err)
+	}
+
+	expected := ProtocolError{ObjectId: surface, Interface: "xdg_surface", Code: 1, CodeName: "not_constructed", Message: "surface not constructed"}
+	if *protocolErr != expected {
+		t.Fatalf("got %+v, expected %+v", *protocolErr, expected)
+	}
+	if msg := protocolErr.Error(); msg != "protocol error not_constructed (1) on xdg_surface#2: surface not constructed" {
+		t.Fatalf("unexpected error message %q", msg)
+	}
+}
+
+func TestListenReturnsMalformedMessage(t *testing.T) {
+	t.Run("header", func(t *testing.T) {
+		client, server := newTestClient(t)
+		if _, err := server.conn.Write([]byte{2, 0, 0, 0, 0, 0, 4, 0}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := client.Listen(); !errors.Is(err, ErrMalformedMessage) {
+			t.Fatalf("Listen returned %v, expected a malformed message", err)
+		}
+	})
+
+	t.Run("arguments", func(t *testing.T) {
+		client, server := newTestClient(t)
+		client.NewObjectId()
+		registry := client.NewObjectId()
+		client.SetInterface(registry, &Interface{Name: "wl_registry", Events: []Method{{Name: "global", Signature: "usu"}}})
+
+		called := false
+		client.On(registry, 0, func(message *Message) {
+			called = true
+		})
+
+		// The string claims to be longer than the rest of the message
+		if err := server.Write(NewMessage(registry, 0, uint32(1), uint32(64), uint32(6))); err != nil {
+			t.Fatal(err)
+		}
+		if err := server.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		var malformed *MalformedMessageError
+		if err := client.Listen(); !errors.As(err, &malformed) {
+			t.Fatalf("Listen returned %v, expected a malformed message", err)
+		}
+		if malformed.ObjectId != registry || malformed.Offset != 4 {
+			t.Fatalf("got %+v, expected the string of object %d at byte 4", *malformed, registry)
+		}
+		if called {
+			t.Fatal("listener was called with a malformed message")
+		}
+	})
+}
+
+func TestListenReturnsUnmatchedFds(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	t.Run("unknown interface", func(t *testing.T) {
+		client, server := newTestClient(t)
+		client.NewObjectId()
+		unknown := client.NewObjectId()
+		client.On(unknown, 0, func(message *Message) {
+			t.Error("listener was called with an event whose file descriptors are unknown")
+		})
+
+		server.Write(NewMessage(unknown, 0).WithFds(int(w.Fd())))
+		server.Flush()
+
+		if err := client.Listen(); err == nil || !strings.Contains(err.Error(), "interface is unknown") {
+			t.Fatalf("Listen returned %v, expected an error about the unknown interface", err)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		client, server := newTestClient(t)
+		client.NewObjectId()
+		buffer := client.NewObjectId()
+		client.SetInterface(buffer, &Interface{Name: "test_buffer", Events: []Method{{Name: "fd", Signature: "h"}}})
+
+		// The kernel discards the file descriptors that don't fit into the control message buffer of the client
+		fds := make([]int, maxFds+1)
+		for i := range fds {
+			fds[i] = int(w.Fd())
+		}
+		var data []byte
+		for range fds {
+			data = append(data, NewMessage(buffer, 0).Bytes()...)
+		}
+		if _, _, err := server.conn.WriteMsgUnix(data, unix.UnixRights(fds...), nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := client.Listen(); err == nil || !strings.Contains(err.Error(), "discarded") {
+			t.Fatalf("Listen returned %v, expected an error about discarded file descriptors", err)
+		}
+	})
+}
+
+func TestTracer(t *testing.T) {
+	client, server := newTestClient(t)
+
+	var output strings.Builder
+	client.SetTracer(NewDebugHandler(&output))
+
+	toplevel := &Interface{
+		Name:     "xdg_toplevel",
+		Requests: []Method{{Name: "destroy", Signature: ""}, {Name: "set_parent", Signature: "?o"}, {Name: "set_title", Signature: "s"}},
+		Events:   []Method{{Name: "configure", Signature: "iia"}},
+	}
+	client.NewObjectId()
+	id := client.NewObjectId()
+	client.SetInterface(id, toplevel)
+	client.SetInterface(client.NewObjectId(), &Interface{Name: "wl_buffer"})
+
+	title := "foo"
+	client.Request(id, 2, title)
+	client.Request(id, 1, uint32(0))
+	client.Request(id, 1, uint32(3))
+	client.Request(id, 5, uint32(1))
+	server.Write(NewMessage(id, 0, int32(-1), int32(600), Uint32Array(1, 2)))
+	server.Flush()
+	if _, err := client.Read(); err != nil {
+		t.Fatal(err)
+	}
+
+	var lines []string
+	for line := range strings.Lines(output.String()) {
+		_, call, _ := strings.Cut(strings.TrimSuffix(line, "\n"), "] ")
+		lines = append(lines, call)
+	}
+
+	expected := []string{
+		` -> xdg_toplevel#2.set_title("foo")`,
+		` -> xdg_toplevel#2.set_parent(nil)`,
+		` -> xdg_toplevel#2.set_parent(wl_buffer#3)`,
+		` -> xdg_toplevel#2.5(01000000)`,
+		`xdg_toplevel#2.configure(-1, 600, array[8])`,
+	}
+	if !slices.Equal(lines, expected) {
+		t.Fatalf("got trace %q, expected %q", lines, expected)
+	}
+}
+
+func TestWriteBatches(t *testing.T) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := Options{WriteBufferSize: 1024}.NewClientFromFd(fds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	server, err := NewClientFromFd(fds[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	id := client.NewObjectId()
+	// The server reads requests as events of the interface
+	server.SetInterface(id, &Interface{Name: "wl_shm", Events: []Method{{Name: "create_pool", Signature: "uh"}}})
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	// More file descriptors than fit into a single sendmsg call, spread over more bytes than the buffer holds
+	const messages = 100
+	go func() {
+		for i := range uint32(messages) {
+			client.Write(NewMessage(id, 0, i).WithFds(int(w.Fd())))
+		}
+		w.Close()
+		client.Flush()
+	}()
+
+	for i := range uint32(messages) {
+		msg, err := server.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := msg.ReadUint32(); n != i {
+			t.Fatalf("got message %d, expected %d", n, i)
+		}
+		if len(msg.Fds) != 1 {
+			t.Fatalf("message %d has %d file descriptors, expected 1", i, len(msg.Fds))
+		}
+		unix.Close(msg.Fds[0])
+	}
+}
+
+// serveSync acts as the compositor for a single wl_display.sync request, sending the events of before first
+func serveSync(t *testing.T, server *Client, before ...*Message) {
+	t.Helper()
+
+	msg, err := server.Read()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if msg.ObjectId != displayObjectId || msg.OpCode != displaySyncOpCode {
+		t.Errorf("got request %d of object %d, expected wl_display.sync", msg.OpCode, msg.ObjectId)
+		return
+	}
+
+	callback := msg.ReadUint32()
+	for _, event := range before {
+		server.Write(event)
+	}
+	server.Write(NewMessage(callback, 0, uint32(0)))
+	server.Write(NewMessage(displayObjectId, displayDeleteIdOpCode, callback))
+	if err := server.Flush(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRoundtrip(t *testing.T) {
+	client, server := newTestClient(t)
+	client.NewObjectId()
+	other := client.NewObjectId()
+
+	delivered := false
+	client.On(other, 0, func(message *Message) {
+		delivered = true
+	})
+
+	go serveSync(t, server, NewMessage(other, 0))
+	if err := client.Roundtrip(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !delivered {
+		t.Fatal("event sent before the done event was not delivered")
+	}
+}
+
+func TestRoundtripWithListen(t *testing.T) {
+	client, server := newTestClient(t)
+	client.NewObjectId()
+	other := client.NewObjectId()
+
+	// Listen may not be running yet, so events are delivered by either of them, but never by both at once
+	var delivering atomic.Int32
+	delivered := 0
+	client.On(other, 0, func(message *Message) {
+		if delivering.Add(1) > 1 {
+			t.Error("events delivered concurrently")
+		}
+		time.Sleep(time.Millisecond)
+		delivered++
+		delivering.Add(-1)
+	})
+	go client.Listen()
+
+	events := make([]*Message, 10)
+	for i := range events {
+		events[i] = NewMessage(other, 0)
+	}
+	go serveSync(t, server, events...)
+	if err := client.Roundtrip(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if delivered != len(events) {
+		t.Fatalf("%d of %d events sent before the done event were delivered", delivered, len(events))
+	}
+
+	go serveSync(t, server, NewMessage(displayObjectId, displayErrorOpCode, uint32(displayObjectId), uint32(1), "invalid method"))
+	var protocolErr *ProtocolError
+	if err := client.Roundtrip(context.Background()); !errors.As(err, &protocolErr) {
+		t.Fatalf("Roundtrip returned %v, expected a protocol error", err)
+	}
+}
+
+func TestRoundtripInListener(t *testing.T) {
+	t.Run("Listen", func(t *testing.T) {
+		client, server := newTestClient(t)
+		client.NewObjectId()
+		other := client.NewObjectId()
+
+		roundtrip := make(chan error, 1)
+		client.On(other, 0, func(message *Message) {
+			roundtrip <- client.Roundtrip(context.Background())
+		})
+		go client.Listen()
+
+		server.Write(NewMessage(other, 0))
+		server.Flush()
+		serveSync(t, server)
+
+		select {
+		case err := <-roundtrip:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Roundtrip called by a listener did not return")
+		}
+	})
+
+	t.Run("Roundtrip", func(t *testing.T) {
+		client, server := newTestClient(t)
+		client.NewObjectId()
+		other := client.NewObjectId()
+
+		roundtrip := make(chan error, 1)
+		client.On(other, 0, func(message *Message) {
+			roundtrip <- client.Roundtrip(context.Background())
+		})
+
+		// The outer roundtrip times out while the listener waits for the nested one, which must not interrupt it
+		go func() {
+			msg, err := server.Read()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			server.Write(NewMessage(other, 0))
+			server.Flush()
+			time.Sleep(100 * time.Millisecond)
+			serveSync(t, server)
+			server.Write(NewMessage(msg.ReadUint32(), 0, uint32(0)))
+			server.Flush()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := client.Roundtrip(ctx); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatal(err)
+		}
+		if err := <-roundtrip; err != nil {
+			t.Fatalf("nested Roundtrip returned %v", err)
+		}
+	})
+}
+
+func TestRoundtripTimeout(t *testing.T) {
+	client, server := newTestClient(t)
+	client.NewObjectId()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Roundtrip(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Roundtrip returned %v, expected the deadline to be exceeded", err)
+	}
+
+	// The compositor answers the first sync late, which must not confuse the next roundtrip
+	go func() {
+		serveSync(t, server)
+		serveSync(t, server)
+	}()
+	if err := client.Roundtrip(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaylandSocket(t *testing.T) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fds[1])
+
+	t.Setenv("WAYLAND_SOCKET", strconv.Itoa(fds[0]))
+	client, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, ok := os.LookupEnv("WAYLAND_SOCKET"); ok {
+		t.Fatal("WAYLAND_SOCKET was not unset")
+	}
+	if _, err := unix.FcntlInt(uintptr(fds[0]), unix.F_GETFD, 0); err != unix.EBADF {
+		t.Fatalf("inherited file descriptor is still open: %v", err)
+	}
+
+	if err := client.Request(displayObjectId, displaySyncOpCode, uint32(2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	if n, err := unix.Read(fds[1], buf); err != nil || n != 12 {
+		t.Fatalf("read %d bytes from the other end of the socket: %v", n, err)
+	}
+}
+
+func TestNewClientFromInvalidFd(t *testing.T) {
+	if _, err := NewClientFromFd(-1); err == nil {
+		t.Fatal("created a client from file descriptor -1")
+	}
+
+	// File descriptors that are valid but not a socket are rejected as well
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	fd, err := unix.Dup(int(r.Fd()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Close()
+	if _, err := NewClientFromFd(fd); err == nil {
+		t.Fatal("created a client from a pipe")
+	}
+}
+
+func TestWaylandDisplayPath(t *testing.T) {
+	address := filepath.Join(t.TempDir(), "compositor")
+	listener, err := net.Listen("unix", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	t.Setenv("WAYLAND_SOCKET", "")
+	t.Setenv("XDG_RUNTIME_DIR", "")
+	t.Setenv("WAYLAND_DISPLAY", address)
+	client, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+
+	t.Setenv("XDG_RUNTIME_DIR", filepath.Dir(address))
+	t.Setenv("WAYLAND_DISPLAY", filepath.Base(address))
+	client, err = NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+}
@@ -0,0 +1,184 @@
+package wayland
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// defaultReadBufferSize is the number of bytes read from the socket at once if Options doesn't specify it
+	defaultReadBufferSize = 4096
+
+	// defaultWriteBufferSize is the number of bytes of requests buffered before they are sent if Options doesn't
+	// specify it
+	defaultWriteBufferSize = 4096
+)
+
+// Options configures a client. The zero value is the configuration used by NewClient, Dial, NewClientFromConn and
+// NewClientFromFd.
+type Options struct {
+	// Tracer receives a record for every request and event, see SetTracer. If nil, tracing depends on WAYLAND_DEBUG.
+	Tracer slog.Handler
+
+	// Logger receives diagnostics that aren't errors, e.g. about events that were discarded. Nothing is logged if
+	// nil.
+	Logger *slog.Logger
+
+	// ReadBufferSize is the number of bytes read from the socket at once, 4096 if 0
+	ReadBufferSize int
+
+	// WriteBufferSize is the number of bytes of requests buffered before they are sent without waiting for Flush,
+	// 4096 if 0
+	WriteBufferSize int
+}
+
+// NewClient creates a new client and tries to connect to the compositor. Like libwayland, it adopts the connected
+// socket inherited through WAYLAND_SOCKET if it is set, and otherwise connects to the socket at WAYLAND_DISPLAY,
+// which is either an absolute path or relative to XDG_RUNTIME_DIR and defaults to wayland-0. Tracing is enabled if
+// WAYLAND_DEBUG is set, see SetTracer.
+func NewClient() (*Client, error) {
+	return Options{}.NewClient()
+}
+
+// Dial creates a new client connected to the compositor listening on the Unix socket at path, e.g. a nested
+// compositor
+func Dial(path string) (*Client, error) {
+	return Options{}.Dial(path)
+}
+
+// NewClientFromConn creates a new client communicating over an established connection, e.g. one end of a socket pair
+func NewClientFromConn(conn *net.UnixConn) *Client {
+	return Options{}.NewClientFromConn(conn)
+}
+
+// NewClientFromFd creates a new client communicating over the connected Unix socket with the file descriptor fd, e.g.
+// one received from a portal. The client takes ownership of fd.
+func NewClientFromFd(fd int) (*Client, error) {
+	return Options{}.NewClientFromFd(fd)
+}
+
+// NewClient is like the package-level NewClient, creating a client configured by options
+func (options Options) NewClient() (*Client, error) {
+	if socket := os.Getenv("WAYLAND_SOCKET"); socket != "" {
+		return options.inheritedClient(socket)
+	}
+
+	address, err := displayAddress()
+	if err != nil {
+		return nil, err
+	}
+	return options.Dial(address)
+}
+
+// Dial is like the package-level Dial, creating a client configured by options
+func (options Options) Dial(path string) (*Client, error) {
+	conn, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	return newClient(conn, options), nil
+}
+
+// NewClientFromConn is like the package-level NewClientFromConn, creating a client configured by options
+func (options Options) NewClientFromConn(conn *net.UnixConn) *Client {
+	return newClient(conn, options)
+}
+
+// NewClientFromFd is like the package-level NewClientFromFd, creating a client configured by options
+func (options Options) NewClientFromFd(fd int) (*Client, error) {
+	if fd < 0 {
+		return nil, fmt.Errorf("invalid file descriptor %d", fd)
+	}
+
+	// FileConn duplicates the file descriptor, so the original one is closed once the connection is established
+	file := os.NewFile(uintptr(fd), "wayland")
+	if file == nil {
+		return nil, fmt.Errorf("invalid file descriptor %d", fd)
+	}
+	defer file.Close()
+
+	conn, err := net.FileConn(file)
+	if err != nil {
+		return nil, err
+	}
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		conn.Close()
+		return nil, errors.New("file descriptor is not a Unix socket")
+	}
+	return newClient(unixConn, options), nil
+}
+
+// inheritedClient adopts the file descriptor of a connected socket passed through WAYLAND_SOCKET by the parent
+// process. The variable is unset, so it isn't passed on to child processes that would try to use the same socket,
+// and the file descriptor is closed on exec.
+func (options Options) inheritedClient(socket string) (*Client, error) {
+	os.Unsetenv("WAYLAND_SOCKET")
+
+	fd, err := strconv.Atoi(socket)
+	if err != nil || fd < 0 {
+		return nil, fmt.Errorf("invalid WAYLAND_SOCKET %q", socket)
+	}
+	unix.CloseOnExec(fd)
+
+	client, err := options.NewClientFromFd(fd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to use WAYLAND_SOCKET: %w", err)
+	}
+	return client, nil
+}
+
+// displayAddress returns the path of the compositor's socket according to WAYLAND_DISPLAY and XDG_RUNTIME_DIR
+func displayAddress() (string, error) {
+	display := os.Getenv("WAYLAND_DISPLAY")
+	if display == "" {
+		display = "wayland-0"
+	}
+	if filepath.IsAbs(display) {
+		return display, nil
+	}
+
+	xdgRuntimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if xdgRuntimeDir == "" {
+		return "", errors.New("no Wayland compositor detected")
+	}
+	return filepath.Join(xdgRuntimeDir, display), nil
+}
+
+// newClient creates a new client communicating over an established connection
+func newClient(conn *net.UnixConn, options Options) *Client {
+	client := &Client{
+		conn:        conn,
+		objects:     make(map[uint32]*Interface),
+		zombies:     make(map[uint32]bool),
+		closed:      make(chan struct{}),
+		dispatching: make(chan struct{}, 1),
+		listeners:   make(map[int]map[int]func(message *Message)),
+		logger:      options.Logger,
+		bufSize:     options.ReadBufferSize,
+		outSize:     options.WriteBufferSize,
+	}
+	if client.logger == nil {
+		client.logger = slog.New(slog.DiscardHandler)
+	}
+	if client.bufSize <= 0 {
+		client.bufSize = defaultReadBufferSize
+	}
+	if client.outSize <= 0 {
+		client.outSize = defaultWriteBufferSize
+	}
+
+	if options.Tracer != nil {
+		client.SetTracer(options.Tracer)
+	} else {
+		client.SetTracer(debugTracer())
+	}
+	return client
+}
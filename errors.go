@@ -0,0 +1,137 @@
+package wayland
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// displayObjectId is the ID of the wl_display singleton, which is always the first object
+	displayObjectId = 1
+
+	// displayErrorOpCode is the opcode of the wl_display.error event
+	displayErrorOpCode = 0
+
+	// displayDeleteIdOpCode is the opcode of the wl_display.delete_id event
+	displayDeleteIdOpCode = 1
+
+	// displaySyncOpCode is the opcode of the wl_display.sync request
+	displaySyncOpCode = 0
+)
+
+// callbackInterface describes wl_callback, which Roundtrip needs to track its wl_display.sync request
+var callbackInterface = &Interface{
+	Name:    "wl_callback",
+	Version: 1,
+	Events:  []Method{{Name: "done", Signature: "u", Destructor: true}},
+}
+
+// ProtocolError is a fatal error reported by the compositor through wl_display.error, after which the connection
+// is no longer usable. Interface and CodeName are empty if the interface of the object isn't known, or if it doesn't
+// describe the error code in its error enum.
+type ProtocolError struct {
+	ObjectId  uint32
+	Interface string
+	Code      uint32
+	CodeName  string
+	Message   string
+}
+
+func (err *ProtocolError) Error() string {
+	code := strconv.FormatUint(uint64(err.Code), 10)
+	if err.CodeName != "" {
+		code = err.CodeName + " (" + code + ")"
+	}
+
+	object := "object " + strconv.FormatUint(uint64(err.ObjectId), 10)
+	if err.Interface != "" {
+		object = err.Interface + "#" + strconv.FormatUint(uint64(err.ObjectId), 10)
+	}
+
+	return fmt.Sprintf("protocol error %s on %s: %s", code, object, err.Message)
+}
+
+// newProtocolError decodes a wl_display.error event without consuming the arguments of msg, looking up the interface
+// of the object to name it and the error code
+func newProtocolError(msg *Message, objectInterface func(objectId uint32) *Interface) (*ProtocolError, error) {
+	args := &Message{ObjectId: msg.ObjectId, OpCode: msg.OpCode, Body: msg.Body}
+	result := &ProtocolError{
+		ObjectId: args.ReadUint32(),
+		Code:     args.ReadUint32(),
+		Message:  args.ReadString(),
+	}
+	if err := args.Err(); err != nil {
+		return nil, err
+	}
+
+	if iface := objectInterface(result.ObjectId); iface != nil {
+		result.Interface = iface.Name
+		if enum, ok := iface.Enum("error"); ok {
+			if entry, ok := enum.Entry(result.Code); ok {
+				result.CodeName = entry.Name
+			}
+		}
+	}
+	return result, nil
+}
+
+// ErrUnsupportedVersion is matched by every *UnsupportedVersionError
+var ErrUnsupportedVersion = errors.New("unsupported version")
+
+// UnsupportedVersionError reports a request or event that was introduced in a later version of an interface than
+// the object it was used with
+type UnsupportedVersionError struct {
+	Interface string
+	Name      string
+	Since     uint32
+	Version   uint32
+}
+
+func (err *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("%s.%s requires version %d, but the object has version %d", err.Interface, err.Name, err.Since, err.Version)
+}
+
+func (err *UnsupportedVersionError) Is(target error) bool {
+	return target == ErrUnsupportedVersion
+}
+
+// ErrObjectDestroyed is matched by every *ObjectDestroyedError
+var ErrObjectDestroyed = errors.New("object destroyed")
+
+// ObjectDestroyedError reports a request that wasn't sent because a destructor request or event already destroyed
+// the object
+type ObjectDestroyedError struct {
+	Interface string
+	ObjectId  uint32
+	Name      string
+}
+
+func (err *ObjectDestroyedError) Error() string {
+	return fmt.Sprintf("%s.%s sent to object %d, which has been destroyed", err.Interface, err.Name, err.ObjectId)
+}
+
+func (err *ObjectDestroyedError) Is(target error) bool {
+	return target == ErrObjectDestroyed
+}
+
+// ErrMalformedMessage is matched by every *MalformedMessageError
+var ErrMalformedMessage = errors.New("malformed message")
+
+// MalformedMessageError reports a message that couldn't be decoded, e.g. because an argument exceeds the size of the
+// message. Offset is the position in the body of the argument that failed, and 0 for an invalid header. The
+// connection can't be used after receiving such a message, as the position of the next message is unknown.
+type MalformedMessageError struct {
+	ObjectId uint32
+	OpCode   uint16
+	Offset   int
+	Reason   string
+}
+
+func (err *MalformedMessageError) Error() string {
+	return fmt.Sprintf("malformed message for object %d with opcode %d at byte %d: %s", err.ObjectId, err.OpCode, err.Offset, err.Reason)
+}
+
+func (err *MalformedMessageError) Is(target error) bool {
+	return target == ErrMalformedMessage
+}
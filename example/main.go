@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"syscall"
+	"time"
 
 	"git.whizanth.com/go/wayland/wlclient"
 	"golang.org/x/sys/unix"
@@ -18,7 +20,12 @@ func main() {
 		os.Exit(1)
 	}
 
-	go client.Listen()
+	go func() {
+		if err := client.Listen(); err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+	}()
 	defer client.Close()
 
 	// Get wl_display
@@ -27,15 +34,27 @@ func main() {
 		fmt.Println("error:", objectId.Interface(), objectId.Id(), code, message)
 	})
 
-	// Bind global objects from registry
-	globals := make(map[string]wlclient.Object)
+	// Collect global objects from registry
+	type global struct {
+		name    uint32
+		version uint32
+	}
+	globals := make(map[string]global)
 
-	registry := display.GetRegistry()
+	registry, err := display.GetRegistry()
+	if err != nil {
+		log.Fatal(err)
+	}
 	registry.OnGlobal(func(name uint32, iface string, version uint32) {
-		globals[iface] = registry.Bind(name, iface, version)
+		globals[iface] = global{name, version}
 	})
 
-	<-display.Sync().OnDone(func(callbackData uint32) {})
+	// Wait until the compositor has announced all globals
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Roundtrip(ctx); err != nil {
+		log.Fatal(err)
+	}
 
 	// Required global objects
 	for _, ext := range []string{"wl_compositor", "xdg_wm_base", "wl_shm"} {
@@ -45,9 +64,18 @@ func main() {
 		}
 	}
 
-	compositor := wlclient.WlCompositor(globals["wl_compositor"])
-	xdgWmBase := wlclient.XdgWmBase(globals["xdg_wm_base"])
-	shm := wlclient.WlShm(globals["wl_shm"])
+	compositor, err := wlclient.Bind[wlclient.WlCompositor](registry, globals["wl_compositor"].name, globals["wl_compositor"].version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	xdgWmBase, err := wlclient.Bind[wlclient.XdgWmBase](registry, globals["xdg_wm_base"].name, globals["xdg_wm_base"].version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	shm, err := wlclient.Bind[wlclient.WlShm](registry, globals["wl_shm"].name, globals["wl_shm"].version)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	// Prevent the application from being marked as "Not responding"
 	xdgWmBase.OnPing(func(serial uint32) {
@@ -55,22 +83,35 @@ func main() {
 	})
 
 	// Create toplevel surface
-	surface := compositor.CreateSurface()
+	surface, err := compositor.CreateSurface()
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	xdgSurface := xdgWmBase.GetXdgSurface(surface)
+	xdgSurface, err := xdgWmBase.GetXdgSurface(surface)
+	if err != nil {
+		log.Fatal(err)
+	}
 	xdgSurface.OnConfigure(func(serial uint32) {
 		xdgSurface.AckConfigure(serial)
 	})
 
-	xdgToplevel := xdgSurface.GetToplevel()
+	xdgToplevel, err := xdgSurface.GetToplevel()
+	if err != nil {
+		log.Fatal(err)
+	}
 	xdgToplevel.SetTitle("My First Wayland Application")
 	xdgToplevel.SetAppId("example")
 
 	surface.Commit()
 
 	// Try adding server-side decorations
-	if decorationManager, ok := globals["zxdg_decoration_manager_v1"]; ok {
-		wlclient.ZxdgDecorationManagerV1(decorationManager).GetToplevelDecoration(xdgToplevel).SetMode(2)
+	if global, ok := globals["zxdg_decoration_manager_v1"]; ok {
+		if decorationManager, err := wlclient.Bind[wlclient.ZxdgDecorationManagerV1](registry, global.name, global.version); err == nil {
+			if decoration, err := decorationManager.GetToplevelDecoration(xdgToplevel); err == nil {
+				decoration.SetMode(wlclient.ZxdgToplevelDecorationV1ModeServerSide)
+			}
+		}
 	}
 
 	// Create framebuffer
@@ -105,9 +146,22 @@ func main() {
 	}
 
 	// Attach framebuffer to surface
-	surface.Attach(shm.CreatePool(fd, int32(width*height*4)).CreateBuffer(0, int32(width), int32(height), int32(width*4), 0), 0, 0)
+	pool, err := shm.CreatePool(fd, int32(width*height*4))
+	if err != nil {
+		log.Fatal(err)
+	}
+	buffer, err := pool.CreateBuffer(0, int32(width), int32(height), int32(width*4), wlclient.WlShmFormatArgb8888)
+	if err != nil {
+		log.Fatal(err)
+	}
+	surface.Attach(&buffer, 0, 0)
 	surface.Commit()
 
+	// Send the buffered requests, as Listen is already waiting for events and won't flush them
+	if err := client.Flush(); err != nil {
+		log.Fatal(err)
+	}
+
 	// Wait until window is closed
 	<-xdgToplevel.OnClose(func() {})
 }
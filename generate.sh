@@ -0,0 +1,30 @@
+#!/bin/sh
+# Regenerates the bindings in wlclient, including the optional wlr, kde and weston packages, from the upstream
+# protocol repositories. They are cloned into $PROTOCOL_DIR, a temporary directory by default, unless they already
+# exist there. The refs below can be overridden to generate the bindings of specific releases, e.g.
+# WAYLAND_PROTOCOLS_REF=1.45 ./generate.sh
+set -eu
+
+module=$(cd "$(dirname "$0")" && pwd)
+dir=${PROTOCOL_DIR:-$(mktemp -d)}
+
+clone() {
+	if [ ! -d "$dir/$1" ]; then
+		git clone --quiet --depth 1 --branch "$3" "$2" "$dir/$1"
+	fi
+}
+
+clone wayland https://gitlab.freedesktop.org/wayland/wayland.git "${WAYLAND_REF:-main}"
+clone wayland-protocols https://gitlab.freedesktop.org/wayland/wayland-protocols.git "${WAYLAND_PROTOCOLS_REF:-main}"
+clone wlr-protocols https://gitlab.freedesktop.org/wlroots/wlr-protocols.git "${WLR_PROTOCOLS_REF:-master}"
+clone plasma-wayland-protocols https://invent.kde.org/libraries/plasma-wayland-protocols.git "${PLASMA_WAYLAND_PROTOCOLS_REF:-master}"
+clone weston https://gitlab.freedesktop.org/wayland/weston.git "${WESTON_REF:-main}"
+
+cd "$module"
+go build -o "$dir/scanner" ./scanner
+
+cd "$dir"
+./scanner -o "$module/wlclient/generated.go" -import git.whizanth.com/go/wayland/wlclient \
+	-root wlr=wlr-protocols/unstable \
+	-root kde=plasma-wayland-protocols/src/protocols \
+	-root weston=weston/protocol
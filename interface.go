@@ -0,0 +1,68 @@
+package wayland
+
+import "strings"
+
+// Interface describes the wire format of a Wayland interface, as generated by the scanner. The opcode of a request
+// or event is its index in Requests or Events.
+type Interface struct {
+	Name     string
+	Version  uint32
+	Requests []Method
+	Events   []Method
+	Enums    []Enum
+}
+
+// Enum returns the enum of the interface with the specified name
+func (iface *Interface) Enum(name string) (Enum, bool) {
+	for _, enum := range iface.Enums {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return Enum{}, false
+}
+
+// Method describes the wire format of a request or event
+type Method struct {
+	Name string
+
+	// Signature lists the argument types using the same characters as libwayland:
+	// i (int), u (uint), f (fixed), s (string), o (object), n (new_id), a (array), h (fd),
+	// with strings and objects that allow null prefixed by ?
+	Signature string
+
+	// NewInterfaces lists the interface of each new_id argument in the order of the signature, which is empty for
+	// new_id arguments of any interface like that of wl_registry.bind
+	NewInterfaces []string
+
+	// Destructor is set for requests and events that destroy the object they are sent to
+	Destructor bool
+}
+
+// Fds returns the number of file descriptors passed along with the method
+func (method Method) Fds() int {
+	return strings.Count(method.Signature, "h")
+}
+
+// Enum describes the named values of an enum, e.g. the error codes of an interface
+type Enum struct {
+	Name     string
+	Bitfield bool
+	Entries  []EnumEntry
+}
+
+// EnumEntry is a named value of an enum
+type EnumEntry struct {
+	Name  string
+	Value uint32
+}
+
+// Entry returns the first entry of the enum with the specified value
+func (enum Enum) Entry(value uint32) (EnumEntry, bool) {
+	for _, entry := range enum.Entries {
+		if entry.Value == value {
+			return entry, true
+		}
+	}
+	return EnumEntry{}, false
+}
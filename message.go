@@ -1,10 +1,8 @@
 package wayland
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
-	"strings"
 	"sync"
 )
 
@@ -13,26 +11,62 @@ type Message struct {
 	OpCode   uint16
 	Size     uint16
 	Body     []byte
-	n        uint16
-	mu       sync.Mutex
+	n        int
 	Fds      []int
 	nextFd   int
+	err      error
 }
 
+// maxMessageSize is the largest size including the header that fits into the 16-bit size field, padded to 32 bits
+// like every message
+const maxMessageSize = 1<<16 - 4
+
+// maxPooledBody is the capacity above which message bodies aren't kept for reuse, so a single large message doesn't
+// pin its memory for the lifetime of the pool
+const maxPooledBody = 4096
+
+var messagePool = sync.Pool{
+	New: func() any {
+		return new(Message)
+	},
+}
+
+// newMessage returns an empty message from the pool, reusing the memory of a released one if possible
+func newMessage(objectId uint32, opcode uint16) *Message {
+	msg := messagePool.Get().(*Message)
+	msg.ObjectId = objectId
+	msg.OpCode = opcode
+	return msg
+}
+
+// Release returns a message to the pool to be reused by NewMessage and Client.Read. The message, including its body,
+// must not be used afterwards. Releasing messages is optional, they are garbage collected like any other value if
+// they aren't released.
+func (msg *Message) Release() {
+	if msg == nil || cap(msg.Body) > maxPooledBody {
+		return
+	}
+
+	*msg = Message{Body: msg.Body[:0]}
+	messagePool.Put(msg)
+}
+
+// Bytes encodes the message including its header
 func (msg *Message) Bytes() []byte {
 	if msg == nil {
 		return nil
 	}
 
-	var buf bytes.Buffer
-	binary.Write(&buf, binary.LittleEndian, msg.ObjectId)
-	binary.Write(&buf, binary.LittleEndian, msg.OpCode)
-	binary.Write(&buf, binary.LittleEndian, uint16(0))
-	binary.Write(&buf, binary.LittleEndian, msg.Body)
+	return msg.AppendBytes(make([]byte, 0, 8+len(msg.Body)))
+}
 
-	result := buf.Bytes()
-	binary.LittleEndian.PutUint16(result[6:8], uint16(buf.Len()))
-	return result
+// AppendBytes appends the encoded message including its header to data and returns the extended slice. The size in
+// the header is only valid for bodies of up to 65524 bytes, which Client.Write ensures.
+func (msg *Message) AppendBytes(data []byte) []byte {
+	data = binary.LittleEndian.AppendUint32(data, msg.ObjectId)
+	data = binary.LittleEndian.AppendUint16(data, msg.OpCode)
+	data = binary.LittleEndian.AppendUint16(data, uint16(8+len(msg.Body)))
+	return append(data, msg.Body...)
 }
 
 func (msg *Message) String() string {
@@ -43,12 +77,38 @@ func (msg *Message) String() string {
 	return fmt.Sprintf("objectId: %d, size: %d, opcode: %d, body: %x", msg.ObjectId, msg.Size, msg.OpCode, msg.Body)
 }
 
+// Err returns the error of the first argument that couldn't be decoded, or nil if all arguments read so far were
+// valid. Readers return zero values once an argument couldn't be decoded.
+func (msg *Message) Err() error {
+	return msg.err
+}
+
+// fail records a decoding error at the current position, unless an earlier argument already failed
+func (msg *Message) fail(format string, args ...any) {
+	if msg.err == nil {
+		msg.err = &MalformedMessageError{ObjectId: msg.ObjectId, OpCode: msg.OpCode, Offset: msg.n, Reason: fmt.Sprintf(format, args...)}
+	}
+}
+
+// remaining reports whether n more bytes of the body can be read, failing otherwise
+func (msg *Message) remaining(n int, arg string) bool {
+	if msg.err != nil {
+		return false
+	}
+	if n > len(msg.Body)-msg.n {
+		msg.fail("%s of %d bytes exceeds the %d remaining bytes", arg, n, len(msg.Body)-msg.n)
+		return false
+	}
+	return true
+}
+
 func (msg *Message) ReadUint32() uint32 {
-	var result uint32
-	msg.mu.Lock()
-	binary.Read(bytes.NewReader(msg.Body[msg.n:msg.n+uint16(4)]), binary.LittleEndian, &result)
+	if !msg.remaining(4, "argument") {
+		return 0
+	}
+
+	result := binary.LittleEndian.Uint32(msg.Body[msg.n:])
 	msg.n += 4
-	msg.mu.Unlock()
 	return result
 }
 
@@ -57,70 +117,205 @@ func (msg *Message) ReadInt32() int32 {
 }
 
 func (msg *Message) ReadString() string {
-	var length uint32
-	msg.mu.Lock()
-	binary.Read(bytes.NewReader(msg.Body[msg.n:msg.n+uint16(4)]), binary.LittleEndian, &length)
-	msg.n += 4
-	result := string(msg.Body[msg.n : msg.n+uint16(length)])
-	msg.n += uint16(length)
-	if length%4 != 0 {
-		msg.n += uint16(4 - length%4)
+	data, ok := msg.readString()
+	if !ok {
+		return ""
+	}
+	return string(data)
+}
+
+// ReadNullableString reads a string argument that allows null, which is encoded with a length of 0 and returned as
+// nil
+func (msg *Message) ReadNullableString() *string {
+	if !msg.remaining(4, "string length") {
+		return nil
+	}
+	if binary.LittleEndian.Uint32(msg.Body[msg.n:]) == 0 {
+		msg.n += 4
+		return nil
 	}
-	msg.mu.Unlock()
-	return strings.TrimSuffix(result, "\x00")
+
+	result := msg.ReadString()
+	return &result
+}
+
+// readString reads the contents of a string argument without copying them or the NUL terminator. Strings have to be
+// NUL-terminated, so a length of 0 is only valid for null strings.
+func (msg *Message) readString() ([]byte, bool) {
+	start := msg.n
+	data := msg.readArray()
+	if msg.err != nil {
+		return nil, false
+	}
+
+	if len(data) == 0 {
+		msg.n = start
+		msg.fail("null string for an argument that doesn't allow null")
+		return nil, false
+	}
+	if data[len(data)-1] != 0 {
+		msg.n = start
+		msg.fail("string of %d bytes is not NUL-terminated", len(data))
+		return nil, false
+	}
+	return data[:len(data)-1], true
 }
 
 func (msg *Message) ReadFixed() Fixed {
 	return Fixed(msg.ReadUint32())
 }
 
-func (msg *Message) ReadArray() []uint32 {
-	// TODO: implement reading arrays
-	return nil
+// ReadArray reads an array argument. The result is a copy, so it remains valid after the message is released.
+func (msg *Message) ReadArray() Array {
+	return Array(append([]byte(nil), msg.readArray()...))
+}
+
+// readArray reads the contents of an array or string argument without copying them, skipping the padding. The
+// length is compared to the remaining body before padding it, so it can't overflow.
+func (msg *Message) readArray() []byte {
+	if !msg.remaining(4, "array length") {
+		return nil
+	}
+	length := uint64(binary.LittleEndian.Uint32(msg.Body[msg.n:]))
+	if length > uint64(len(msg.Body)-msg.n-4) {
+		msg.fail("array of %d bytes exceeds the %d remaining bytes", length, len(msg.Body)-msg.n-4)
+		return nil
+	}
+	padded := int(length+3) &^ 3
+	if !msg.remaining(4+padded, "padded array") {
+		return nil
+	}
+
+	result := msg.Body[msg.n+4 : msg.n+4+int(length)]
+	msg.n += 4 + padded
+	return result
 }
 
+// ReadFd returns the next file descriptor passed along with the message, or -1 if there are none left
 func (msg *Message) ReadFd() int {
-	if msg.nextFd < len(msg.Fds) {
-		return msg.Fds[msg.nextFd]
+	if msg.err != nil {
+		return -1
+	}
+	if msg.nextFd >= len(msg.Fds) {
+		msg.fail("file descriptor %d is missing, only %d were passed along", msg.nextFd+1, len(msg.Fds))
+		return -1
 	}
-	return 0
+
+	msg.nextFd++
+	return msg.Fds[msg.nextFd-1]
 }
 
+// validate decodes every argument of signature without consuming them, returning the IDs of new_id arguments, or the
+// error of the first malformed argument
+func (msg *Message) validate(signature string) ([]uint32, error) {
+	args := &Message{ObjectId: msg.ObjectId, OpCode: msg.OpCode, Body: msg.Body, Fds: msg.Fds}
+	var newIds []uint32
+	nullable := false
+	for _, argType := range signature {
+		switch argType {
+		case '?':
+			nullable = true
+			continue
+		case 'i', 'u', 'f', 'o':
+			args.ReadUint32()
+		case 'n':
+			newIds = append(newIds, args.ReadUint32())
+		case 's':
+			if nullable {
+				args.ReadNullableString()
+			} else {
+				args.readString()
+			}
+		case 'a':
+			args.readArray()
+		case 'h':
+			args.ReadFd()
+		}
+		nullable = false
+	}
+	return newIds, args.err
+}
+
+// WithFds passes file descriptors along with the message and returns it. It returns nil for a nil message, so it can
+// be chained to NewMessage, leaving the error to Client.Write.
 func (msg *Message) WithFds(fd ...int) *Message {
+	if msg == nil {
+		return nil
+	}
+
 	msg.Fds = fd
 	return msg
 }
 
+// NewMessage encodes a message from a list of arguments, or returns nil if any argument is of an unsupported type or
+// the message would exceed the maximum size of 65532 bytes. The message is taken from the pool and returned to it by
+// Client.Write.
 func NewMessage(objectId uint32, opcode uint16, args ...any) *Message {
-	result := &Message{
-		ObjectId: objectId,
-		OpCode:   opcode,
-	}
+	result := newMessage(objectId, opcode)
 
-	var buf bytes.Buffer
+	body := result.Body
 	for _, arg := range args {
 		switch arg := arg.(type) {
 		case int:
-			binary.Write(&buf, binary.LittleEndian, uint32(arg))
+			body = binary.LittleEndian.AppendUint32(body, uint32(arg))
 		case int32:
-			binary.Write(&buf, binary.LittleEndian, uint32(arg))
+			body = binary.LittleEndian.AppendUint32(body, uint32(arg))
 		case uint32:
-			binary.Write(&buf, binary.LittleEndian, arg)
+			body = binary.LittleEndian.AppendUint32(body, arg)
+		case Fixed:
+			body = binary.LittleEndian.AppendUint32(body, uint32(arg))
 		case string:
-			binary.Write(&buf, binary.LittleEndian, uint32(len(arg)+1))
-			binary.Write(&buf, binary.LittleEndian, []byte(arg+"\x00"))
-			if (len(arg)+1)%4 != 0 {
-				binary.Write(&buf, binary.LittleEndian, make([]byte, 4-(len(arg)+1)%4))
+			body = appendString(body, arg)
+		case *string:
+			// Null strings are encoded with a length of 0 and no contents
+			if arg == nil {
+				body = binary.LittleEndian.AppendUint32(body, 0)
+			} else {
+				body = appendString(body, *arg)
 			}
+		case []byte:
+			body = appendArray(body, arg)
+		case Array:
+			body = appendArray(body, arg)
 		case []uint32:
-			// TODO: writing arrays
+			body = binary.LittleEndian.AppendUint32(body, uint32(len(arg)*4))
+			for _, value := range arg {
+				body = binary.LittleEndian.AppendUint32(body, value)
+			}
 		default:
+			result.Body = body
+			result.Release()
 			return nil
 		}
 	}
 
-	result.Body = buf.Bytes()
-	result.Size = uint16(8 + len(result.Body))
+	result.Body = body
+	if 8+len(body) > maxMessageSize {
+		result.Release()
+		return nil
+	}
+	result.Size = uint16(8 + len(body))
 
 	return result
 }
+
+// appendString appends a string argument, which is NUL-terminated and padded to 32 bits
+func appendString(body []byte, arg string) []byte {
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(arg)+1))
+	body = append(body, arg...)
+	return appendPadding(append(body, 0), len(arg)+1)
+}
+
+// appendArray appends an array argument, which is padded to 32 bits
+func appendArray(body []byte, arg []byte) []byte {
+	body = binary.LittleEndian.AppendUint32(body, uint32(len(arg)))
+	return appendPadding(append(body, arg...), len(arg))
+}
+
+// appendPadding pads an argument of length bytes to 32 bits
+func appendPadding(body []byte, length int) []byte {
+	for range (4 - length%4) % 4 {
+		body = append(body, 0)
+	}
+	return body
+}
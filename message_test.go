@@ -0,0 +1,309 @@
+package wayland
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// motionEvent encodes a wl_pointer.motion event, which compositors send at the rate of the pointer device
+func motionEvent(objectId uint32, time uint32) []byte {
+	data := make([]byte, 20)
+	binary.LittleEndian.PutUint32(data[0:], objectId)
+	binary.LittleEndian.PutUint16(data[4:], 2)
+	binary.LittleEndian.PutUint16(data[6:], uint16(len(data)))
+	binary.LittleEndian.PutUint32(data[8:], time)
+	binary.LittleEndian.PutUint32(data[12:], uint32(ParseFixed(12.5)))
+	binary.LittleEndian.PutUint32(data[16:], uint32(ParseFixed(-3.25)))
+	return data
+}
+
+func TestMessageRoundtrip(t *testing.T) {
+	title := "foo"
+	msg := NewMessage(3, 1, uint32(7), int32(-2), ParseFixed(1.5), "hello", &title, (*string)(nil), Uint32Array(4, 5), []byte{6})
+	if msg == nil {
+		t.Fatal("unable to encode message")
+	}
+
+	data := msg.Bytes()
+	if int(binary.LittleEndian.Uint16(data[6:8])) != len(data) || int(msg.Size) != len(data) {
+		t.Fatalf("message of %d bytes has size %d in its header and %d in its struct", len(data), binary.LittleEndian.Uint16(data[6:8]), msg.Size)
+	}
+	if len(data)%4 != 0 {
+		t.Fatalf("message of %d bytes is not padded to 32 bits", len(data))
+	}
+
+	decoded := &Message{ObjectId: binary.LittleEndian.Uint32(data[0:4]), OpCode: binary.LittleEndian.Uint16(data[4:6]), Body: data[8:]}
+	if decoded.ObjectId != 3 || decoded.OpCode != 1 {
+		t.Fatalf("got object %d and opcode %d, expected 3 and 1", decoded.ObjectId, decoded.OpCode)
+	}
+	if value := decoded.ReadUint32(); value != 7 {
+		t.Fatalf("got uint %d, expected 7", value)
+	}
+	if value := decoded.ReadInt32(); value != -2 {
+		t.Fatalf("got int %d, expected -2", value)
+	}
+	if value := decoded.ReadFixed().Float64(); value != 1.5 {
+		t.Fatalf("got fixed %v, expected 1.5", value)
+	}
+	if value := decoded.ReadString(); value != "hello" {
+		t.Fatalf("got string %q, expected hello", value)
+	}
+	if value := decoded.ReadNullableString(); value == nil || *value != title {
+		t.Fatalf("got string %v, expected %q", value, title)
+	}
+	if value := decoded.ReadNullableString(); value != nil {
+		t.Fatalf("got string %q, expected nil", *value)
+	}
+	if value := decoded.ReadArray().Uint32s(); len(value) != 2 || value[0] != 4 || value[1] != 5 {
+		t.Fatalf("got array %v, expected [4 5]", value)
+	}
+	if value := decoded.ReadArray(); len(value) != 1 || value[0] != 6 {
+		t.Fatalf("got array %v, expected [6]", value)
+	}
+}
+
+func TestMessageTooLarge(t *testing.T) {
+	if msg := NewMessage(1, 0, strings.Repeat("a", 70000)); msg != nil {
+		t.Fatalf("encoded a message of %d bytes with size %d", 8+len(msg.Body), msg.Size)
+	}
+	if msg := NewMessage(1, 0, make([]byte, maxMessageSize-12)); msg == nil || int(msg.Size) != maxMessageSize {
+		t.Fatal("unable to encode a message of the maximum size")
+	}
+
+	client, _ := newTestClient(t)
+	if err := client.Write(&Message{ObjectId: 1, Body: make([]byte, maxMessageSize)}); err == nil {
+		t.Fatal("wrote a message that exceeds the maximum size")
+	}
+}
+
+// encodeBody encodes raw 32-bit words followed by raw bytes as a message body
+func encodeBody(words []uint32, data ...byte) []byte {
+	var body []byte
+	for _, word := range words {
+		body = binary.LittleEndian.AppendUint32(body, word)
+	}
+	return append(body, data...)
+}
+
+func TestMalformedMessage(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		body   []byte
+		read   func(msg *Message)
+		offset int
+	}{
+		{"short uint", []byte{1, 2}, func(msg *Message) { msg.ReadUint32() }, 0},
+		{"uint after end", encodeBody([]uint32{1}), func(msg *Message) { msg.ReadUint32(); msg.ReadUint32() }, 4},
+		{"string length overflow", encodeBody([]uint32{0xffffffff}, 'a', 0, 0, 0), func(msg *Message) { msg.ReadString() }, 0},
+		{"string missing padding", encodeBody([]uint32{3}, 'a', 'b', 0), func(msg *Message) { msg.ReadString() }, 0},
+		{"string not terminated", encodeBody([]uint32{4}, 'a', 'b', 'c', 'd'), func(msg *Message) { msg.ReadString() }, 0},
+		{"null string", encodeBody([]uint32{7, 0}), func(msg *Message) { msg.ReadUint32(); msg.ReadString() }, 4},
+		{"nullable string not terminated", encodeBody([]uint32{1}, 'a', 0, 0, 0), func(msg *Message) { msg.ReadNullableString() }, 0},
+		{"array length overflow", encodeBody([]uint32{0xfffffffd}), func(msg *Message) { msg.ReadArray() }, 0},
+		{"array missing padding", encodeBody([]uint32{5}, 1, 2, 3, 4, 5), func(msg *Message) { msg.ReadArray() }, 0},
+		{"missing fd", nil, func(msg *Message) { msg.ReadFd() }, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			msg := &Message{ObjectId: 3, OpCode: 1, Body: test.body}
+			test.read(msg)
+
+			var malformed *MalformedMessageError
+			if !errors.As(msg.Err(), &malformed) || !errors.Is(msg.Err(), ErrMalformedMessage) {
+				t.Fatalf("got error %v, expected a malformed message", msg.Err())
+			}
+			if malformed.ObjectId != 3 || malformed.OpCode != 1 || malformed.Offset != test.offset {
+				t.Fatalf("got error %+v, expected object 3, opcode 1 and offset %d", *malformed, test.offset)
+			}
+
+			// Arguments after the malformed one are never decoded
+			if value := msg.ReadUint32(); value != 0 || msg.Err() != error(malformed) {
+				t.Fatalf("read %d after the error, which changed to %v", value, msg.Err())
+			}
+		})
+	}
+}
+
+// readArgs decodes every argument of signature with the exported readers
+func readArgs(msg *Message, signature string) {
+	nullable := false
+	for _, argType := range signature {
+		switch argType {
+		case '?':
+			nullable = true
+			continue
+		case 'i':
+			msg.ReadInt32()
+		case 'u', 'o', 'n':
+			msg.ReadUint32()
+		case 'f':
+			msg.ReadFixed()
+		case 's':
+			if nullable {
+				msg.ReadNullableString()
+			} else {
+				msg.ReadString()
+			}
+		case 'a':
+			msg.ReadArray()
+		case 'h':
+			msg.ReadFd()
+		}
+		nullable = false
+	}
+}
+
+// FuzzRead feeds arbitrary bytes to Read, decoding every message it returns with signature
+func FuzzRead(f *testing.F) {
+	f.Add("us", NewMessage(2, 0, uint32(1), "wl_compositor").Bytes())
+	f.Add("a?s", append(NewMessage(3, 1, Uint32Array(1, 2), (*string)(nil)).Bytes(), NewMessage(3, 2, "x").Bytes()...))
+	f.Add("u", []byte{2, 0, 0, 0, 0, 0, 4, 0})
+	f.Add("s", []byte{2, 0, 0, 0, 0, 0, 12, 0, 0xff, 0xff, 0xff, 0xff})
+	f.Add("uuh", encodeBody([]uint32{1, 0x000c0000, 5}))
+
+	f.Fuzz(func(t *testing.T, signature string, data []byte) {
+		client, server := newTestClient(t)
+		go func() {
+			server.conn.Write(data)
+			server.conn.CloseWrite()
+		}()
+
+		for {
+			msg, err := client.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			} else if err != nil {
+				if !errors.Is(err, ErrMalformedMessage) {
+					t.Fatalf("Read returned %v, expected a malformed message or the end of the connection", err)
+				}
+				return
+			}
+			if int(msg.Size) != 8+len(msg.Body) {
+				t.Fatalf("message of size %d has a body of %d bytes", msg.Size, len(msg.Body))
+			}
+
+			_, err = msg.validate(signature)
+			readArgs(msg, signature)
+			if (err == nil) != (msg.Err() == nil) {
+				t.Fatalf("validation returned %v, but decoding returned %v", err, msg.Err())
+			}
+			msg.Release()
+		}
+	})
+}
+
+// FuzzReaders calls a reader method for each byte of ops on an arbitrary body
+func FuzzReaders(f *testing.F) {
+	f.Add([]byte{0, 3, 5}, NewMessage(2, 0, uint32(1), "foo", Uint32Array(2)).Bytes()[8:])
+	f.Add([]byte{4, 4, 2}, NewMessage(2, 0, (*string)(nil), "", ParseFixed(1.5)).Bytes()[8:])
+	f.Add([]byte{3}, encodeBody([]uint32{0xffffffff}))
+	f.Add([]byte{5, 6}, encodeBody([]uint32{2}, 1, 2))
+
+	f.Fuzz(func(t *testing.T, ops []byte, body []byte) {
+		msg := &Message{Body: body, Fds: []int{3}}
+		var first error
+		for _, op := range ops {
+			n := msg.n
+			switch op % 7 {
+			case 0:
+				msg.ReadUint32()
+			case 1:
+				msg.ReadInt32()
+			case 2:
+				msg.ReadFixed()
+			case 3:
+				msg.ReadString()
+			case 4:
+				msg.ReadNullableString()
+			case 5:
+				msg.ReadArray()
+			case 6:
+				msg.ReadFd()
+			}
+
+			if msg.n > len(body) || msg.n%4 != 0 {
+				t.Fatalf("read up to byte %d of a body of %d bytes", msg.n, len(body))
+			}
+			if first != nil && (msg.Err() != first || msg.n != n) {
+				t.Fatalf("reading after the error %v moved to byte %d and returned %v", first, msg.n, msg.Err())
+			}
+			first = msg.Err()
+		}
+	})
+}
+
+// BenchmarkDispatch measures reading and delivering pointer motion events
+func BenchmarkDispatch(b *testing.B) {
+	client, server := newTestClient(b)
+	pointer := client.NewObjectId()
+	client.SetInterface(pointer, &Interface{Name: "wl_pointer", Events: []Method{{Name: "enter"}, {Name: "leave"}, {Name: "motion", Signature: "uff"}}})
+
+	var x, y float64
+	client.On(pointer, 2, func(message *Message) {
+		message.ReadUint32()
+		x += message.ReadFixed().Float64()
+		y += message.ReadFixed().Float64()
+	})
+
+	// Send the events in batches like a compositor would, until the connection is closed after the benchmark
+	const batch = 64
+	var data []byte
+	for i := range uint32(batch) {
+		data = append(data, motionEvent(pointer, i)...)
+	}
+	go func() {
+		for {
+			if _, err := server.conn.Write(data); err != nil {
+				return
+			}
+		}
+	}()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := client.dispatch(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkRequest measures encoding and sending requests with a few arguments, like wl_surface.damage_buffer
+func BenchmarkRequest(b *testing.B) {
+	client, server := newTestClient(b)
+	surface := client.NewObjectId()
+
+	go func() {
+		buf := make([]byte, 65536)
+		for {
+			if _, err := server.conn.Read(buf); err != nil {
+				return
+			}
+		}
+	}()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := client.Request(surface, 9, int32(0), int32(0), int32(800), int32(600)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := client.Flush(); err != nil {
+		b.Fatal(err)
+	}
+}
+
+// BenchmarkDecode measures decoding the arguments of a message
+func BenchmarkDecode(b *testing.B) {
+	data := NewMessage(2, 0, uint32(1), ParseFixed(2.5), ParseFixed(-4), "wl_compositor").Bytes()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		msg := Message{Body: data[8:]}
+		msg.ReadUint32()
+		msg.ReadFixed()
+		msg.ReadFixed()
+		msg.ReadString()
+	}
+}
@@ -0,0 +1,776 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// goPackage is a Go package bindings are generated into
+type goPackage struct {
+	name       string
+	importPath string
+	// core is set for the package containing the runtime, which all other packages import
+	core bool
+}
+
+// goFile is a generated Go file containing the bindings of one or more protocols
+type goFile struct {
+	gen     *generator
+	path    string
+	pkg     *goPackage
+	doc     string
+	runtime bool
+	// bitfields is set if the file contains bitfield enums, which need the enum runtime, and enumRuntime is set for
+	// the file declaring it, which is the first such file of a package other than the core package
+	bitfields   bool
+	enumRuntime bool
+	ifaces      []Interface
+	imports     map[string]string
+	body        strings.Builder
+	registry    strings.Builder
+}
+
+// generator holds all generated files, and resolves references to interfaces and enums across them
+type generator struct {
+	waylandImport string
+	// bridge is set if any package imports the core package, which then exports what its bindings need
+	bridge   bool
+	core     *goPackage
+	files    []*goFile
+	ifaces   map[string]*goFile
+	enums    map[string]*goFile
+	registry strings.Builder
+}
+
+func newGenerator(waylandImport string, core *goPackage) *generator {
+	return &generator{
+		waylandImport: waylandImport,
+		core:          core,
+		ifaces:        make(map[string]*goFile),
+		enums:         make(map[string]*goFile),
+	}
+}
+
+// file returns the generated file with the specified path, creating it if it doesn't exist yet
+func (gen *generator) file(filePath string, pkg *goPackage) *goFile {
+	for _, f := range gen.files {
+		if f.path == filePath {
+			return f
+		}
+	}
+
+	f := &goFile{gen: gen, path: filePath, pkg: pkg, imports: make(map[string]string)}
+	gen.files = append(gen.files, f)
+	return f
+}
+
+// add assigns an interface to the file it is generated in
+func (gen *generator) add(f *goFile, iface Interface) {
+	f.ifaces = append(f.ifaces, iface)
+	gen.ifaces[iface.Name] = f
+	for _, enum := range iface.Enums {
+		gen.enums[iface.Name+"."+enum.Name] = f
+	}
+}
+
+// generate writes all files, after checking that the packages don't import each other in a cycle
+func (gen *generator) generate() error {
+	gen.bridge = slices.ContainsFunc(gen.files, func(f *goFile) bool { return !f.pkg.core })
+
+	for _, f := range gen.files {
+		for _, iface := range f.ifaces {
+			f.generateInterface(iface)
+		}
+	}
+
+	if err := gen.checkCycles(); err != nil {
+		return err
+	}
+
+	enumRuntimes := make(map[*goPackage]bool)
+	for _, f := range gen.files {
+		if f.bitfields && !f.pkg.core && !enumRuntimes[f.pkg] {
+			f.enumRuntime = true
+			enumRuntimes[f.pkg] = true
+		}
+	}
+
+	for _, f := range gen.files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(f.path, f.render(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCycles returns an error if the generated packages import each other in a cycle, which happens if protocols
+// reference each other's interfaces
+func (gen *generator) checkCycles() error {
+	imports := make(map[string][]string)
+	for _, f := range gen.files {
+		for importPath := range f.imports {
+			imports[f.pkg.importPath] = append(imports[f.pkg.importPath], importPath)
+		}
+		if !f.pkg.core {
+			imports[f.pkg.importPath] = append(imports[f.pkg.importPath], gen.core.importPath)
+		}
+	}
+
+	visited := make(map[string]bool)
+	var visit func(importPath string, stack []string) error
+	visit = func(importPath string, stack []string) error {
+		if i := slices.Index(stack, importPath); i >= 0 {
+			return fmt.Errorf("import cycle between generated packages: %s", strings.Join(append(stack[i:], importPath), " -> "))
+		} else if visited[importPath] {
+			return nil
+		}
+		visited[importPath] = true
+
+		for _, dependency := range imports[importPath] {
+			if err := visit(dependency, append(stack, importPath)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, f := range gen.files {
+		if err := visit(f.pkg.importPath, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// render returns the source code of the file, consisting of the package clause, the imports used by the generated
+// code, the runtime and the bindings
+func (f *goFile) render() []byte {
+	var builder strings.Builder
+
+	if f.runtime {
+		f.use("errors")
+		f.use("net")
+		f.use("strconv")
+		f.use("strings")
+		f.use("sync")
+		f.use("sync/atomic")
+		f.wayland()
+	} else if f.enumRuntime {
+		f.use("strconv")
+		f.use("strings")
+	}
+	if !f.runtime && f.registry.Len() > 0 {
+		f.wayland()
+	}
+
+	if f.doc != "" {
+		writeComment(&builder, "", f.doc)
+	}
+	builder.WriteString("package " + f.pkg.name + "\n")
+	builder.WriteString("\n")
+
+	var std, other []string
+	for importPath := range f.imports {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			other = append(other, importPath)
+		} else {
+			std = append(std, importPath)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(other)
+
+	if len(std)+len(other) > 0 {
+		builder.WriteString("import (\n")
+		for i, group := range [][]string{std, other} {
+			if i > 0 && len(std) > 0 && len(other) > 0 {
+				builder.WriteString("\n")
+			}
+			for _, importPath := range group {
+				if name := f.imports[importPath]; path.Base(importPath) != name {
+					builder.WriteString("	" + name + ` "` + importPath + `"` + "\n")
+				} else {
+					builder.WriteString(`	"` + importPath + `"` + "\n")
+				}
+			}
+		}
+		builder.WriteString(")\n")
+		builder.WriteString("\n")
+	}
+
+	if f.runtime {
+		builder.WriteString(runtime)
+		if f.gen.bridge {
+			builder.WriteString(bridgeRuntime)
+		}
+	} else if f.enumRuntime {
+		builder.WriteString(enumRuntime)
+	}
+
+	builder.WriteString(f.body.String())
+
+	if f.runtime {
+		builder.WriteString("var interfaces = map[string]*wayland.Interface{\n")
+		builder.WriteString(f.gen.registry.String())
+		builder.WriteString("}\n")
+	} else if f.registry.Len() > 0 {
+		builder.WriteString("func init() {\n")
+		builder.WriteString("	for _, iface := range []*wayland.Interface{\n")
+		builder.WriteString(f.registry.String())
+		builder.WriteString("	} {\n")
+		builder.WriteString("		" + f.core() + "RegisterInterface(iface)\n")
+		builder.WriteString("	}\n")
+		builder.WriteString("}\n")
+	}
+
+	return []byte(builder.String())
+}
+
+// use records that the file uses an import, returning the qualifier of the imported package
+func (f *goFile) use(importPath string) string {
+	name := path.Base(importPath)
+	f.imports[importPath] = name
+	return name + "."
+}
+
+// wayland returns the qualifier of the wayland package
+func (f *goFile) wayland() string {
+	f.imports[f.gen.waylandImport] = "wayland"
+	return "wayland."
+}
+
+// qualifier returns the qualifier of identifiers declared in pkg, which is empty within pkg itself
+func (f *goFile) qualifier(pkg *goPackage) string {
+	if pkg == f.pkg {
+		return ""
+	}
+
+	f.imports[pkg.importPath] = pkg.name
+	return pkg.name + "."
+}
+
+// core returns the qualifier of the package containing the runtime
+func (f *goFile) core() string {
+	return f.qualifier(f.gen.core)
+}
+
+// runtimeName returns the name of an unexported runtime function, which protocol packages can only access through
+// its exported counterpart
+func (f *goFile) runtimeName(name string) string {
+	if f.pkg.core {
+		return name
+	}
+	return toPascalCase(name)
+}
+
+// field returns an expression for a field of the Object underlying expr
+func (f *goFile) field(expr string, name string) string {
+	if f.pkg.core {
+		return expr + "." + name
+	}
+	return f.core() + "Object(" + expr + ")." + toPascalCase(name) + "()"
+}
+
+// objectType returns the Go type of an argument referencing an object of the specified interface, which is the
+// untyped Object if the interface isn't generated
+func (f *goFile) objectType(iface string) string {
+	target, ok := f.gen.ifaces[iface]
+	if !ok {
+		return f.core() + "Object"
+	}
+	return f.qualifier(target.pkg) + toPascalCase(iface)
+}
+
+// nullable reports whether an argument can be null, which is only supported for strings and objects. Such arguments
+// are pointers in the generated code, with nil for null.
+func nullable(arg Argument) bool {
+	return arg.AllowNull && (arg.Type == "string" || arg.Type == "object")
+}
+
+// argEnum returns the Go type of the enum an argument of iface references, or an empty string if the argument has no
+// enum or the enum isn't generated
+func (f *goFile) argEnum(iface string, arg Argument) string {
+	if arg.Enum == "" || (arg.Type != "uint" && arg.Type != "int") {
+		return ""
+	}
+
+	key := arg.Enum
+	if !strings.Contains(key, ".") {
+		key = iface + "." + key
+	}
+	target, ok := f.gen.enums[key]
+	if !ok {
+		return ""
+	}
+	return f.qualifier(target.pkg) + enumType(iface, arg.Enum)
+}
+
+// generateEnumSince generates the since method of an enum with entries introduced after the first version of its
+// interface, which requests use to check their enum arguments against the version of the object
+func (f *goFile) generateEnumSince(iface Interface, enum Enum, entries []Entry) {
+	builder := &f.body
+	typeName := enumType(iface.Name, enum.Name)
+
+	builder.WriteString("// since returns the version of " + iface.Name + " value was introduced in\n")
+	builder.WriteString("func (value " + typeName + ") since() uint32 {\n")
+	if enum.Bitfield {
+		builder.WriteString("	result := uint32(1)\n")
+		for _, entry := range entries {
+			if entry.Since > 1 {
+				builder.WriteString("	if value&" + typeName + toPascalCase(entry.Name) + " != 0 {\n")
+				builder.WriteString("		result = max(result, " + strconv.Itoa(entry.Since) + ")\n")
+				builder.WriteString("	}\n")
+			}
+		}
+		builder.WriteString("	return result\n")
+	} else {
+		builder.WriteString("	switch value {\n")
+		for _, entry := range entries {
+			if entry.Since > 1 {
+				builder.WriteString("	case " + typeName + toPascalCase(entry.Name) + ":\n")
+				builder.WriteString("		return " + strconv.Itoa(entry.Since) + "\n")
+			}
+		}
+		builder.WriteString("	}\n")
+		builder.WriteString("	return 1\n")
+	}
+	builder.WriteString("}\n")
+	builder.WriteString("\n")
+}
+
+// versioned reports whether an enum has entries introduced after the first version of its interface
+func versioned(enum Enum) bool {
+	for _, entry := range enum.Entries {
+		if entry.Since > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// ownEnum returns the enum of an argument if it is defined by the interface of the method itself, whose version the
+// since attributes of its entries refer to
+func ownEnum(iface Interface, arg Argument) (Enum, bool) {
+	if arg.Enum == "" || (arg.Type != "uint" && arg.Type != "int") {
+		return Enum{}, false
+	}
+
+	name, ok := strings.CutPrefix(arg.Enum, iface.Name+".")
+	if !ok && strings.Contains(arg.Enum, ".") {
+		return Enum{}, false
+	}
+	for _, enum := range iface.Enums {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return Enum{}, false
+}
+
+func (f *goFile) generateEnum(iface Interface, enum Enum) {
+	builder := &f.body
+	typeName := enumType(iface.Name, enum.Name)
+
+	writeComment(builder, "", description(typeName+" is the "+enum.Name+" enum of "+iface.Name, enum.Description))
+	builder.WriteString("type " + typeName + " uint32\n")
+	builder.WriteString("\n")
+	builder.WriteString("const (\n")
+	for _, entry := range enum.Entries {
+		comment := strings.TrimSpace(entry.Summary)
+		if entry.Since > 1 {
+			if comment != "" {
+				comment += "\n\n"
+			}
+			comment += "Available since version " + strconv.Itoa(entry.Since) + " of " + iface.Name + "."
+		}
+		if comment != "" {
+			writeComment(builder, "	", comment)
+		}
+		builder.WriteString("	" + typeName + toPascalCase(entry.Name) + " " + typeName + " = " + entry.Value + "\n")
+	}
+	builder.WriteString(")\n")
+	builder.WriteString("\n")
+
+	// Entries can share a value, only the first one is used for String
+	seen := make(map[uint64]bool)
+	var entries []Entry
+	for _, entry := range enum.Entries {
+		value, err := strconv.ParseUint(entry.Value, 0, 32)
+		if err != nil {
+			fmt.Printf("(!) invalid value %s of %s.%s\n", entry.Value, iface.Name, enum.Name)
+			continue
+		}
+		if !seen[value] && (!enum.Bitfield || value&(value-1) == 0) {
+			seen[value] = true
+			entries = append(entries, entry)
+		}
+	}
+
+	builder.WriteString("func (value " + typeName + ") String() string {\n")
+	if enum.Bitfield {
+		f.bitfields = true
+		builder.WriteString("	return bitfieldString(uint32(value), []enumEntry{\n")
+		for _, entry := range entries {
+			builder.WriteString("		{" + entry.Value + `, "` + entry.Name + `"},` + "\n")
+		}
+		builder.WriteString("	})\n")
+	} else {
+		builder.WriteString("	switch value {\n")
+		for _, entry := range entries {
+			builder.WriteString("	case " + typeName + toPascalCase(entry.Name) + ":\n")
+			builder.WriteString(`		return "` + entry.Name + `"` + "\n")
+		}
+		builder.WriteString("	}\n")
+		builder.WriteString("	return " + f.use("strconv") + "FormatUint(uint64(value), 10)\n")
+	}
+	builder.WriteString("}\n")
+	builder.WriteString("\n")
+
+	if versioned(enum) {
+		f.generateEnumSince(iface, enum, entries)
+	}
+
+	if enum.Bitfield {
+		builder.WriteString("// Has reports whether all flags set in flag are also set in value\n")
+		builder.WriteString("func (value " + typeName + ") Has(flag " + typeName + ") bool {\n")
+		builder.WriteString("	return value&flag == flag\n")
+		builder.WriteString("}\n")
+		builder.WriteString("\n")
+	}
+}
+
+func (f *goFile) generateInterface(iface Interface) {
+	builder := &f.body
+	registryBuilder := &f.registry
+	indent := "	"
+	if f.pkg.core {
+		registryBuilder = &f.gen.registry
+	} else {
+		indent = "		"
+	}
+
+	writeComment(builder, "", description(toPascalCase(iface.Name)+" is the "+iface.Name+" interface", iface.Description))
+	builder.WriteString("type " + toPascalCase(iface.Name) + " " + f.core() + "Object\n")
+	builder.WriteString("\n")
+	builder.WriteString("// Interface returns the name of the interface, which is used by Bind\n")
+	builder.WriteString("func (" + toPascalCase(iface.Name) + ") Interface() string {\n")
+	builder.WriteString(`	return "` + iface.Name + `"` + "\n")
+	builder.WriteString("}\n")
+	builder.WriteString("\n")
+
+	for _, enum := range iface.Enums {
+		f.generateEnum(iface, enum)
+	}
+
+	if f.pkg.core {
+		registryBuilder.WriteString(indent + `"` + iface.Name + `": {` + "\n")
+	} else {
+		registryBuilder.WriteString(indent + "{\n")
+	}
+	registryBuilder.WriteString(indent + `	Name: "` + iface.Name + `",` + "\n")
+	registryBuilder.WriteString(indent + "	Version: " + strconv.Itoa(iface.Version) + ",\n")
+	for _, methods := range []struct {
+		field   string
+		methods []Method
+	}{{"Requests", iface.Requests}, {"Events", iface.Events}} {
+		if len(methods.methods) == 0 {
+			continue
+		}
+
+		registryBuilder.WriteString(indent + "	" + methods.field + ": []" + f.wayland() + "Method{\n")
+		for _, method := range methods.methods {
+			registryBuilder.WriteString(indent + `		{Name: "` + method.Name + `", Signature: "` + signature(method) + `"`)
+			if newInterfaces := newInterfaces(method); len(newInterfaces) > 0 {
+				registryBuilder.WriteString(", NewInterfaces: []string{" + strings.Join(newInterfaces, ", ") + "}")
+			}
+			if method.Type == "destructor" {
+				registryBuilder.WriteString(", Destructor: true")
+			}
+			registryBuilder.WriteString("},\n")
+		}
+		registryBuilder.WriteString(indent + "	},\n")
+	}
+	if len(iface.Enums) > 0 {
+		registryBuilder.WriteString(indent + "	Enums: []" + f.wayland() + "Enum{\n")
+		for _, enum := range iface.Enums {
+			registryBuilder.WriteString(indent + "		{\n")
+			registryBuilder.WriteString(indent + `			Name: "` + enum.Name + `",` + "\n")
+			if enum.Bitfield {
+				registryBuilder.WriteString(indent + "			Bitfield: true,\n")
+			}
+			registryBuilder.WriteString(indent + "			Entries: []" + f.wayland() + "EnumEntry{\n")
+			for _, entry := range enum.Entries {
+				if _, err := strconv.ParseUint(entry.Value, 0, 32); err != nil {
+					continue
+				}
+				registryBuilder.WriteString(indent + `				{Name: "` + entry.Name + `", Value: ` + entry.Value + "},\n")
+			}
+			registryBuilder.WriteString(indent + "			},\n")
+			registryBuilder.WriteString(indent + "		},\n")
+		}
+		registryBuilder.WriteString(indent + "	},\n")
+	}
+	registryBuilder.WriteString(indent + "},\n")
+
+	client := f.field("object", "client")
+
+	for opCode, request := range iface.Requests {
+		var argsBuilder strings.Builder
+		var returnsBuilder strings.Builder
+		var newsBuilder strings.Builder
+		var freesBuilder strings.Builder
+		var msgArgsBuilder strings.Builder
+		var fdBuilder strings.Builder
+		var returnBuilder strings.Builder
+		var zeroBuilder strings.Builder
+
+		fd := 0
+		newId := 0
+		args := 0
+		returns := 0
+
+		for _, arg := range request.Args {
+			if arg.Type == "new_id" {
+				if newId > 0 {
+					returnsBuilder.WriteString(", ")
+					returnBuilder.WriteString(", ")
+					zeroBuilder.WriteString(", ")
+				}
+
+				if arg.Interface == "" {
+					if args > 0 {
+						argsBuilder.WriteString(", ")
+					}
+					argsBuilder.WriteString("iface string, version uint32")
+					args++
+					msgArgsBuilder.WriteString(", iface, version")
+				}
+
+				returnsBuilder.WriteString("" + f.objectType(arg.Interface))
+				returnBuilder.WriteString("" + toCamelCase(arg.Name))
+				zeroBuilder.WriteString(f.objectType(arg.Interface) + "{}")
+				returns++
+
+				newsBuilder.WriteString("	" + toCamelCase(arg.Name) + " := ")
+
+				if _, ok := f.gen.ifaces[arg.Interface]; ok {
+					newsBuilder.WriteString(f.objectType(arg.Interface) + "(" + client + "." + f.runtimeName("newObject") + `("` + arg.Interface + `", ` + f.field("object", "version") + "))")
+				} else if arg.Interface != "" {
+					newsBuilder.WriteString(client + "." + f.runtimeName("newObject") + `("` + arg.Interface + `", ` + f.field("object", "version") + ")")
+				} else {
+					newsBuilder.WriteString(client + "." + f.runtimeName("newObject") + "(iface, version)")
+				}
+
+				newsBuilder.WriteString("\n\n")
+
+				// The compositor never learns about objects of requests that couldn't be sent, so they are freed again
+				freesBuilder.WriteString("		" + client + ".FreeObjectId(" + f.field(toCamelCase(arg.Name), "id") + ")\n")
+
+				msgArgsBuilder.WriteString(", " + f.field(toCamelCase(arg.Name), "id"))
+				newId++
+
+				continue
+
+			}
+
+			if args > 0 {
+				argsBuilder.WriteString(", ")
+			}
+
+			argsBuilder.WriteString(toCamelCase(arg.Name) + " ")
+
+			if nullable(arg) {
+				argsBuilder.WriteString("*")
+			}
+
+			if enum := f.argEnum(iface.Name, arg); enum != "" {
+				argsBuilder.WriteString(enum)
+			} else if arg.Type == "string" {
+				argsBuilder.WriteString("string")
+			} else if arg.Type == "uint" {
+				argsBuilder.WriteString("uint32")
+			} else if arg.Type == "int" {
+				argsBuilder.WriteString("int32")
+			} else if arg.Type == "enum" {
+				argsBuilder.WriteString("uint32")
+			} else if arg.Type == "object" {
+				argsBuilder.WriteString(f.objectType(arg.Interface))
+			} else if arg.Type == "fixed" {
+				argsBuilder.WriteString(f.wayland() + "Fixed")
+			} else if arg.Type == "array" {
+				argsBuilder.WriteString(f.wayland() + "Array")
+			} else if arg.Type == "fd" {
+				argsBuilder.WriteString("int")
+
+				if fd > 0 {
+					fdBuilder.WriteString(", ")
+				}
+
+				fdBuilder.WriteString(toCamelCase(arg.Name))
+				fd++
+				args++
+				continue
+			} else {
+				fmt.Println("(!) unsupported type: " + arg.Type)
+			}
+
+			if f.argEnum(iface.Name, arg) != "" && arg.Type == "uint" {
+				msgArgsBuilder.WriteString(", uint32(" + toCamelCase(arg.Name) + ")")
+			} else if f.argEnum(iface.Name, arg) != "" && arg.Type == "int" {
+				msgArgsBuilder.WriteString(", int32(" + toCamelCase(arg.Name) + ")")
+			} else if arg.Type == "object" && nullable(arg) {
+				msgArgsBuilder.WriteString(", " + f.core() + f.runtimeName("nullableId") + "(" + toCamelCase(arg.Name) + ")")
+			} else if arg.Type == "object" {
+				msgArgsBuilder.WriteString(", " + f.field(toCamelCase(arg.Name), "id"))
+			} else {
+				msgArgsBuilder.WriteString(", " + toCamelCase(arg.Name))
+			}
+
+			args++
+		}
+
+		writeComment(builder, "", methodComment(iface, toPascalCase(request.Name)+" sends the "+request.Name+" request", request))
+		builder.WriteString("func (object " + toPascalCase(iface.Name) + ") " + toPascalCase(request.Name) + "(")
+		builder.WriteString(argsBuilder.String())
+		builder.WriteString(") ")
+
+		if returns > 0 {
+			builder.WriteString("(" + returnsBuilder.String() + ", error) ")
+		} else {
+			builder.WriteString("error ")
+		}
+
+		builder.WriteString("{\n")
+
+		builder.WriteString("	if err := " + f.core() + "Object(object)." + f.runtimeName("checkRequest") + `("` + request.Name + `", ` + strconv.Itoa(max(request.Since, 1)) + "); err != nil {\n")
+		if returns > 0 {
+			builder.WriteString("		return " + zeroBuilder.String() + ", err\n")
+		} else {
+			builder.WriteString("		return err\n")
+		}
+		builder.WriteString("	}\n")
+		for _, arg := range request.Args {
+			if enum, ok := ownEnum(iface, arg); ok && versioned(enum) && f.argEnum(iface.Name, arg) != "" {
+				name := toCamelCase(arg.Name)
+				builder.WriteString("	if since := " + name + ".since(); since > 1 {\n")
+				builder.WriteString("		if err := " + f.core() + "Object(object)." + f.runtimeName("checkVersion") + `("` + request.Name + " with " + arg.Name + ` " + ` + name + ".String(), since); err != nil {\n")
+				if returns > 0 {
+					builder.WriteString("			return " + zeroBuilder.String() + ", err\n")
+				} else {
+					builder.WriteString("			return err\n")
+				}
+				builder.WriteString("		}\n")
+				builder.WriteString("	}\n")
+			}
+		}
+		builder.WriteString("\n")
+
+		builder.WriteString(newsBuilder.String())
+
+		msg := f.wayland() + "NewMessage(" + f.field("object", "id") + ", " + strconv.Itoa(opCode) + msgArgsBuilder.String() + ")"
+		if fd > 0 {
+			msg += ".WithFds(" + fdBuilder.String() + ")"
+		}
+		write := client + ".Write(" + msg + ")"
+		if request.Type == "destructor" {
+			write = client + "." + f.runtimeName("writeDestructor") + "(" + f.field("object", "id") + ", " + msg + ")"
+		}
+
+		if returns > 0 {
+			builder.WriteString("	if err := " + write + "; err != nil {\n")
+			builder.WriteString(freesBuilder.String())
+			builder.WriteString("		return " + zeroBuilder.String() + ", err\n")
+			builder.WriteString("	}\n")
+			builder.WriteString("\n")
+			builder.WriteString("	return " + returnBuilder.String() + ", nil\n")
+		} else {
+			builder.WriteString("	return " + write + "\n")
+		}
+
+		builder.WriteString("}\n")
+		builder.WriteString("\n")
+	}
+
+	for opCode, event := range iface.Events {
+		var args1Builder strings.Builder
+		var args2Builder strings.Builder
+
+		args := 0
+
+		for _, arg := range event.Args {
+			if args > 0 {
+				args1Builder.WriteString(", ")
+				args2Builder.WriteString(", ")
+			}
+
+			args1Builder.WriteString(toCamelCase(arg.Name) + " ")
+
+			if enum := f.argEnum(iface.Name, arg); enum != "" && arg.Type == "uint" {
+				args1Builder.WriteString(enum)
+				args2Builder.WriteString(enum + "(message.ReadUint32())")
+			} else if enum != "" && arg.Type == "int" {
+				args1Builder.WriteString(enum)
+				args2Builder.WriteString(enum + "(message.ReadInt32())")
+			} else if arg.Type == "string" && nullable(arg) {
+				args1Builder.WriteString("*string")
+				args2Builder.WriteString("message.ReadNullableString()")
+			} else if arg.Type == "string" {
+				args1Builder.WriteString("string")
+				args2Builder.WriteString("message.ReadString()")
+			} else if arg.Type == "uint" {
+				args1Builder.WriteString("uint32")
+				args2Builder.WriteString("message.ReadUint32()")
+			} else if arg.Type == "int" || arg.Type == "enum" {
+				args1Builder.WriteString("int32")
+				args2Builder.WriteString("message.ReadInt32()")
+			} else if arg.Type == "fixed" {
+				args1Builder.WriteString(f.wayland() + "Fixed")
+				args2Builder.WriteString("message.ReadFixed()")
+			} else if arg.Type == "object" && nullable(arg) {
+				args1Builder.WriteString("*" + f.objectType(arg.Interface))
+				args2Builder.WriteString(f.core() + f.runtimeName("resolveNullable") + "[" + f.objectType(arg.Interface) + "](" + client + ", message.ReadUint32())")
+			} else if arg.Type == "object" {
+				if _, ok := f.gen.ifaces[arg.Interface]; ok {
+					args1Builder.WriteString(f.objectType(arg.Interface))
+					args2Builder.WriteString(f.objectType(arg.Interface) + "(" + client + "." + f.runtimeName("resolve") + "(message.ReadUint32()))")
+				} else {
+					args1Builder.WriteString(f.core() + "Object")
+					args2Builder.WriteString(client + "." + f.runtimeName("resolve") + "(message.ReadUint32())")
+				}
+			} else if arg.Type == "fd" {
+				args1Builder.WriteString("int")
+				args2Builder.WriteString("message.ReadFd()")
+			} else if arg.Type == "array" {
+				args1Builder.WriteString(f.wayland() + "Array")
+				args2Builder.WriteString("message.ReadArray()")
+			} else if arg.Type == "new_id" {
+				args1Builder.WriteString(f.objectType(arg.Interface))
+				// The object has been registered through OnNewObject before the event is delivered
+				args2Builder.WriteString(f.objectType(arg.Interface) + "(" + client + "." + f.runtimeName("resolve") + "(message.ReadUint32()))")
+			}
+
+			args++
+		}
+
+		writeComment(builder, "", methodComment(iface, "On"+toPascalCase(event.Name)+" registers a listener for the "+event.Name+" event", event))
+		builder.WriteString("func (object " + toPascalCase(iface.Name) + ") On" + toPascalCase(event.Name) + "(listener func(")
+		builder.WriteString(args1Builder.String())
+		builder.WriteString(")) chan struct{} {\n")
+		if event.Since > 1 {
+			builder.WriteString("	" + client + "." + f.runtimeName("unsupported") + "(" + f.core() + "Object(object)." + f.runtimeName("checkVersion") + `("` + event.Name + `", ` + strconv.Itoa(event.Since) + "))\n")
+		}
+		builder.WriteString("	return " + client + ".On(" + f.field("object", "id") + ", " + strconv.Itoa(opCode) + ", func(message *" + f.wayland() + "Message) {\n")
+		builder.WriteString("		listener(" + args2Builder.String() + ")\n")
+		builder.WriteString("	})\n")
+		builder.WriteString("}\n")
+		builder.WriteString("\n")
+	}
+}
@@ -2,9 +2,14 @@ package main
 
 import (
 	"encoding/xml"
+	"errors"
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -12,23 +17,27 @@ import (
 // Wayland XML schema
 
 type Protocol struct {
+	Name       string      `xml:"name,attr"`
 	Copyright  string      `xml:"copyright"`
 	Interfaces []Interface `xml:"interface"`
 }
 
 type Interface struct {
-	Name     string   `xml:"name,attr"`
-	Version  int      `xml:"version,attr"`
-	Requests []Method `xml:"request"`
-	Events   []Method `xml:"event"`
-	Enums    []Enum   `xml:"enum"`
+	Name        string      `xml:"name,attr"`
+	Version     int         `xml:"version,attr"`
+	Description Description `xml:"description"`
+	Requests    []Method    `xml:"request"`
+	Events      []Method    `xml:"event"`
+	Enums       []Enum      `xml:"enum"`
 }
 
 type Method struct {
-	Name        string      `xml:"name,attr"`
-	Type        string      `xml:"type,attr"`
-	Description Description `xml:"description"`
-	Args        []Argument  `xml:"arg"`
+	Name            string      `xml:"name,attr"`
+	Type            string      `xml:"type,attr"`
+	Since           int         `xml:"since,attr"`
+	DeprecatedSince int         `xml:"deprecated-since,attr"`
+	Description     Description `xml:"description"`
+	Args            []Argument  `xml:"arg"`
 }
 
 type Argument struct {
@@ -36,11 +45,13 @@ type Argument struct {
 	Type      string `xml:"type,attr"`
 	Interface string `xml:"interface,attr"`
 	Enum      string `xml:"enum,attr"`
+	AllowNull bool   `xml:"allow-null,attr"`
 	Summary   string `xml:"summary,attr"`
 }
 
 type Enum struct {
 	Name        string      `xml:"name,attr"`
+	Bitfield    bool        `xml:"bitfield,attr"`
 	Description Description `xml:"description"`
 	Entries     []Entry     `xml:"entry"`
 }
@@ -49,6 +60,7 @@ type Entry struct {
 	Name    string `xml:"name,attr"`
 	Value   string `xml:"value,attr"`
 	Summary string `xml:"summary,attr"`
+	Since   int    `xml:"since,attr"`
 }
 
 type Description struct {
@@ -56,6 +68,9 @@ type Description struct {
 	Full    string `xml:",chardata"`
 }
 
+// maxCommentWidth is the column generated comments are wrapped at
+const maxCommentWidth = 120
+
 func toPascalCase(str string) string {
 	var builder strings.Builder
 
@@ -100,17 +115,16 @@ func toCamelCase(str string) string {
 	return result
 }
 
-func main() {
-	var builder strings.Builder
-	builder.WriteString(`package wlclient
-
-import "git.whizanth.com/go/wayland"
+// runtime is written to the package containing wl_display, after the package clause and imports
+const runtime = objectRuntime + enumRuntime + clientRuntime
 
-type Object struct {
+const objectRuntime = `type Object struct {
 	client  *Client
 	id      uint32
 	iface   string
 	version uint32
+	// destroyed is shared by all copies of an object, and set once a destructor request or event destroyed it
+	destroyed *atomic.Bool
 }
 
 func (object Object) Id() uint32 {
@@ -121,259 +135,979 @@ func (object Object) Interface() string {
 	return object.iface
 }
 
+// Version returns the version of the interface the object was created with, which child objects inherit
+func (object Object) Version() uint32 {
+	return object.version
+}
+
+// checkVersion returns an error if the object's version predates the version a request or event was introduced in.
+// Objects of unknown version, e.g. ones missing from the object table, always pass.
+func (object Object) checkVersion(name string, since uint32) error {
+	if object.version == 0 || object.version >= since {
+		return nil
+	}
+
+	return &wayland.UnsupportedVersionError{
+		Interface: object.iface,
+		Name:      name,
+		Since:     since,
+		Version:   object.version,
+	}
+}
+
+// checkRequest returns an error if a request can't be sent, either because the object has been destroyed or because
+// its version predates the version the request was introduced in
+func (object Object) checkRequest(name string, since uint32) error {
+	if object.destroyed != nil && object.destroyed.Load() {
+		return &wayland.ObjectDestroyedError{
+			Interface: object.iface,
+			ObjectId:  object.id,
+			Name:      name,
+		}
+	}
+	return object.checkVersion(name, since)
+}
+
+`
+
+// enumRuntime is additionally written to protocol packages containing bitfield enums
+const enumRuntime = `// enumEntry is a named value of an enum
+type enumEntry struct {
+	value uint32
+	name  string
+}
+
+// bitfieldString formats the flags set in value as names separated by "|", using the single-bit entries of a
+// bitfield enum and falling back to numbers for unknown flags
+func bitfieldString(value uint32, entries []enumEntry) string {
+	var names []string
+	for _, entry := range entries {
+		if entry.value == 0 {
+			if value == 0 {
+				return entry.name
+			}
+		} else if value&entry.value == entry.value {
+			names = append(names, entry.name)
+			value &^= entry.value
+		}
+	}
+
+	if value != 0 || len(names) == 0 {
+		names = append(names, strconv.FormatUint(uint64(value), 10))
+	}
+	return strings.Join(names, "|")
+}
+
+`
+
+const clientRuntime = `// proxy is implemented by every generated object type
+type proxy interface {
+	~struct {
+		client    *Client
+		id        uint32
+		iface     string
+		version   uint32
+		destroyed *atomic.Bool
+	}
+	Interface() string
+}
+
+// Bind binds the global with the specified name to a new object of type T, using the highest version supported by
+// both the compositor and these bindings. T has to be a generated interface type, Object can't be bound.
+func Bind[T proxy](registry WlRegistry, name uint32, version uint32) (T, error) {
+	var zero T
+	iface, ok := interfaces[zero.Interface()]
+	if !ok {
+		return zero, errors.New("unable to bind " + strconv.Quote(zero.Interface()) + ", which is not a known interface")
+	}
+	if iface.Version < version {
+		version = iface.Version
+	}
+
+	object, err := registry.Bind(name, iface.Name, version)
+	if err != nil {
+		return zero, err
+	}
+	return T(object), nil
+}
+
+// LookupInterface returns the wire format of the interface with the specified name, including the names of its
+// requests, events and enums. Interfaces of protocol packages are only known once the package has been imported.
+func LookupInterface(name string) (*wayland.Interface, bool) {
+	iface, ok := interfaces[name]
+	return iface, ok
+}
+
+// Options configures a client, see wayland.Options
+type Options wayland.Options
+
+// New creates a new client and tries to connect to the compositor, see wayland.NewClient
 func New() (*Client, error) {
-	client, err := wayland.NewClient()
+	return Options{}.New()
+}
+
+// Dial creates a new client connected to the compositor listening on the Unix socket at path, see wayland.Dial
+func Dial(path string) (*Client, error) {
+	return Options{}.Dial(path)
+}
+
+// NewFromConn creates a new client communicating over an established connection, see wayland.NewClientFromConn
+func NewFromConn(conn *net.UnixConn) *Client {
+	return Options{}.NewFromConn(conn)
+}
+
+// NewFromFd creates a new client communicating over the connected Unix socket with the file descriptor fd, see
+// wayland.NewClientFromFd
+func NewFromFd(fd int) (*Client, error) {
+	return Options{}.NewFromFd(fd)
+}
+
+// New is like the package-level New, creating a client configured by options
+func (options Options) New() (*Client, error) {
+	client, err := wayland.Options(options).NewClient()
+	if err != nil {
+		return nil, err
+	}
+	return newClient(client), nil
+}
+
+// Dial is like the package-level Dial, creating a client configured by options
+func (options Options) Dial(path string) (*Client, error) {
+	client, err := wayland.Options(options).Dial(path)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(client), nil
+}
+
+// NewFromConn is like the package-level NewFromConn, creating a client configured by options
+func (options Options) NewFromConn(conn *net.UnixConn) *Client {
+	return newClient(wayland.Options(options).NewClientFromConn(conn))
+}
+
+// NewFromFd is like the package-level NewFromFd, creating a client configured by options
+func (options Options) NewFromFd(fd int) (*Client, error) {
+	client, err := wayland.Options(options).NewClientFromFd(fd)
 	if err != nil {
 		return nil, err
 	}
+	return newClient(client), nil
+}
 
-	result := &Client{Client: client}
-	result.display.client = result
-	result.display.id = result.NewObjectId()
-	result.display.iface = "wl_display"
-	return result, nil
+// newClient creates the bindings of a client of the wayland package
+func newClient(client *wayland.Client) *Client {
+	result := &Client{
+		Client:  client,
+		objects: make(map[uint32]Object),
+	}
+	result.OnFreeObjectId(result.forget)
+	result.OnDestroyObject(result.markDestroyed)
+	result.OnNewObject(result.newServerObject)
+	result.display = WlDisplay(result.newObject("wl_display", 1))
+	return result
 }
 
 type Client struct {
 	*wayland.Client
-	display WlDisplay
+	display       WlDisplay
+	mu            sync.Mutex
+	objects       map[uint32]Object
+	onUnsupported func(err error)
 }
 
 func (client *Client) GetDisplay() WlDisplay {
 	return client.display
 }
 
-`)
-	ifaces := make(map[string]bool)
+// OnUnsupportedListener calls listener whenever a listener is registered for an event the object's version doesn't
+// support, and which therefore will never be called
+func (client *Client) OnUnsupportedListener(listener func(err error)) {
+	client.mu.Lock()
+	client.onUnsupported = listener
+	client.mu.Unlock()
+}
 
-	generateClient(ifaces, &builder, filepath.Join("wayland", "protocol", "wayland.xml"))
+// unsupported reports err to the listener registered with OnUnsupportedListener, unless it is nil
+func (client *Client) unsupported(err error) {
+	client.mu.Lock()
+	listener := client.onUnsupported
+	client.mu.Unlock()
 
-	for _, protocolStage := range []string{"stable", "staging", "experimental", "unstable"} {
-		protocolDirs, _ := os.ReadDir(filepath.Join("wayland-protocols", protocolStage))
-		for _, dir := range protocolDirs {
-			files, _ := os.ReadDir(filepath.Join("wayland-protocols", protocolStage, dir.Name()))
-			for _, file := range files {
-				if strings.HasSuffix(file.Name(), ".xml") {
-					generateClient(ifaces, &builder, filepath.Join("wayland-protocols", protocolStage, dir.Name(), file.Name()))
-				}
+	if err != nil && listener != nil {
+		listener(err)
+	}
+}
+
+// Object returns the live object with the specified ID
+func (client *Client) Object(id uint32) (Object, bool) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	object, ok := client.objects[id]
+	return object, ok
+}
+
+// newObject allocates a new object ID and registers the object, including the interface needed to decode its events
+func (client *Client) newObject(iface string, version uint32) Object {
+	return client.register(Object{client: client, id: client.NewObjectId(), iface: iface, version: version, destroyed: new(atomic.Bool)})
+}
+
+// newServerObject registers an object the compositor created through a new_id event argument, with the version of
+// the object the event was sent to. This happens before the event is delivered, whether or not it has a listener, so
+// listeners attached to the object from within the event listener will receive all of its events.
+func (client *Client) newServerObject(id uint32, iface string, parentId uint32) {
+	parent, _ := client.Object(parentId)
+	client.register(Object{client: client, id: id, iface: iface, version: parent.version, destroyed: new(atomic.Bool)})
+}
+
+// register adds an object to the object table and records the interface needed to decode its events
+func (client *Client) register(object Object) Object {
+	client.SetInterface(object.id, interfaces[object.iface])
+
+	client.mu.Lock()
+	client.objects[object.id] = object
+	client.mu.Unlock()
+	return object
+}
+
+// resolve returns the object referenced by an event argument, falling back to an object of unknown interface if the
+// ID isn't in the object table
+func (client *Client) resolve(id uint32) Object {
+	if object, ok := client.Object(id); ok {
+		return object
+	}
+	return Object{client: client, id: id}
+}
+
+// nullableId returns the ID of an object argument that allows null, which is 0 for nil
+func nullableId[T proxy](object *T) uint32 {
+	if object == nil {
+		return 0
+	}
+	return Object(*object).id
+}
+
+// resolveNullable returns the object referenced by an event argument that allows null, or nil if the argument is
+// null
+func resolveNullable[T proxy](client *Client, id uint32) *T {
+	if id == 0 {
+		return nil
+	}
+
+	object := T(client.resolve(id))
+	return &object
+}
+
+// writeDestructor sends a destructor request and destroys the object. Client-side IDs are destroyed before sending, as
+// the compositor can confirm the destruction before Write returns, while server-side IDs are freed right away and
+// thus destroyed after sending, so the request can still be traced.
+func (client *Client) writeDestructor(id uint32, msg *wayland.Message) error {
+	if id >= wayland.ServerIdStart {
+		err := client.Write(msg)
+		client.DestroyObject(id)
+		return err
+	}
+
+	client.DestroyObject(id)
+	if err := client.Write(msg); err != nil {
+		// No delete_id confirms a destructor that was never sent, so the ID is freed instead of staying a zombie
+		client.FreeObjectId(id)
+		return err
+	}
+	return nil
+}
+
+// markDestroyed marks an object as destroyed, so further requests on any copy of it fail
+func (client *Client) markDestroyed(id uint32) {
+	client.mu.Lock()
+	object, ok := client.objects[id]
+	client.mu.Unlock()
+
+	if ok && object.destroyed != nil {
+		object.destroyed.Store(true)
+	}
+}
+
+// forget removes an object from the object table after its ID has been freed
+func (client *Client) forget(id uint32) {
+	client.mu.Lock()
+	delete(client.objects, id)
+	client.mu.Unlock()
+}
+
+`
+
+// bridgeRuntime is additionally written to the core package if protocols are generated into their own packages, and
+// exports what the bindings in those packages need
+const bridgeRuntime = `// RegisterInterface adds the interface of a protocol package to the interfaces known to the client. It is called
+// by the generated bindings of protocol packages when they are initialized.
+func RegisterInterface(iface *wayland.Interface) {
+	interfaces[iface.Name] = iface
+}
+
+// Client returns the client the object belongs to
+func (object Object) Client() *Client {
+	return object.client
+}
+
+// CheckVersion returns an error if the object's version predates the version a request or event was introduced in
+func (object Object) CheckVersion(name string, since uint32) error {
+	return object.checkVersion(name, since)
+}
+
+// CheckRequest returns an error if a request can't be sent, either because the object has been destroyed or because
+// its version predates the version the request was introduced in
+func (object Object) CheckRequest(name string, since uint32) error {
+	return object.checkRequest(name, since)
+}
+
+// Unsupported reports err to the listener registered with OnUnsupportedListener, unless it is nil
+func (client *Client) Unsupported(err error) {
+	client.unsupported(err)
+}
+
+// WriteDestructor sends a destructor request and destroys the object
+func (client *Client) WriteDestructor(id uint32, msg *wayland.Message) error {
+	return client.writeDestructor(id, msg)
+}
+
+// NewObject allocates a new object ID and registers the object, including the interface needed to decode its events
+func (client *Client) NewObject(iface string, version uint32) Object {
+	return client.newObject(iface, version)
+}
+
+// Resolve returns the object referenced by an event argument, falling back to an object of unknown interface if the
+// ID isn't in the object table
+func (client *Client) Resolve(id uint32) Object {
+	return client.resolve(id)
+}
+
+// NullableId returns the ID of an object argument that allows null, which is 0 for nil
+func NullableId[T proxy](object *T) uint32 {
+	return nullableId(object)
+}
+
+// ResolveNullable returns the object referenced by an event argument that allows null, or nil if the argument is
+// null
+func ResolveNullable[T proxy](client *Client, id uint32) *T {
+	return resolveNullable[T](client, id)
+}
+
+`
+
+// patterns is a flag that collects comma-separated glob patterns, and can be repeated
+type patterns []string
+
+func (p *patterns) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *patterns) Set(value string) error {
+	for _, pattern := range strings.Split(value, ",") {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		*p = append(*p, pattern)
+	}
+	return nil
+}
+
+// match reports whether the name of an interface of the specified namespace matches any of the patterns. Patterns
+// prefixed with "namespace:" only match interfaces of protocol roots with that namespace.
+func (p patterns) match(namespace string, name string) bool {
+	for _, pattern := range p {
+		if before, after, ok := strings.Cut(pattern, ":"); ok {
+			if before != namespace {
+				continue
 			}
+			pattern = after
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
 		}
 	}
+	return false
+}
 
-	os.WriteFile(filepath.Join("wlclient", "generated.go"), []byte(builder.String()), 0755)
+// root is an additional protocol root, whose bindings are generated into their own package named after the
+// namespace
+type root struct {
+	namespace string
+	dir       string
 }
 
-func generateClient(ifaces map[string]bool, builder *strings.Builder, xmlPath string) {
-	content, err := os.ReadFile(xmlPath)
-	if err != nil {
-		fmt.Printf("unable to read %s: %v\n", xmlPath, err)
-		return
-	}
+// roots is a flag that collects protocol roots in the format "namespace=directory", and can be repeated
+type roots []root
 
-	var proto Protocol
-	if err := xml.Unmarshal(content, &proto); err != nil {
-		fmt.Printf("unable to unmarshal %s: %v\n", xmlPath, err)
-		return
+func (r *roots) String() string {
+	var values []string
+	for _, root := range *r {
+		values = append(values, root.namespace+"="+root.dir)
 	}
+	return strings.Join(values, ",")
+}
 
-	for _, iface := range proto.Interfaces {
-		if _, ok := ifaces[iface.Name]; ok {
-			// duplicate
-			continue
+func (r *roots) Set(value string) error {
+	namespace, dir, ok := strings.Cut(value, "=")
+	if !ok || dir == "" {
+		return fmt.Errorf("invalid root %q, expected namespace=directory", value)
+	}
+	if namespace == "" || strings.Trim(namespace, "abcdefghijklmnopqrstuvwxyz0123456789") != "" || namespace[0] <= '9' {
+		return fmt.Errorf("invalid namespace %q, expected a lowercase Go package name", namespace)
+	}
+	for _, root := range *r {
+		if root.namespace == namespace {
+			return fmt.Errorf("namespace %q is used more than once", namespace)
 		}
+	}
 
-		ifaces[iface.Name] = true
+	*r = append(*r, root{namespace: namespace, dir: dir})
+	return nil
+}
 
-		builder.WriteString("type " + toPascalCase(iface.Name) + " Object\n")
-		builder.WriteString("\n")
+// protocolInput is a protocol read from the inputs, along with the interfaces that are generated from it
+type protocolInput struct {
+	namespace string
+	file      string
+	proto     Protocol
+	ifaces    []Interface
+}
 
-		for opCode, request := range iface.Requests {
-			var argsBuilder strings.Builder
-			var returnsBuilder strings.Builder
-			var newsBuilder strings.Builder
-			var msgArgsBuilder strings.Builder
-			var fdBuilder strings.Builder
-			var returnBuilder strings.Builder
+// source returns the file a protocol was read from, prefixed by the namespace of its root
+func (input *protocolInput) source() string {
+	if input.namespace != "" {
+		return input.namespace + ":" + input.file
+	}
+	return input.file
+}
 
-			fd := 0
-			newId := 0
-			args := 0
-			returns := 0
+func usage(flags *flag.FlagSet) {
+	fmt.Fprintf(flags.Output(), `Usage: scanner [flags] [file.xml | directory]...
 
-			for _, arg := range request.Args {
-				if arg.Type == "new_id" {
-					if newId > 0 {
-						returnsBuilder.WriteString(", ")
-						returnBuilder.WriteString(", ")
-					}
+Generates Go bindings for the interfaces of the specified Wayland protocol XML files. Directories are searched
+recursively for XML files. Without any inputs, wayland/protocol/wayland.xml and the stable, staging, experimental and
+unstable protocols of wayland-protocols are used. wl_display and wl_registry are required by the generated client.
 
-					if arg.Interface == "" {
-						arg.Interface = "Object"
+Interfaces are generated in the order they are found. Arguments referencing interfaces or enums that aren't generated
+use the untyped Object and integer types instead.
 
-						if args > 0 {
-							argsBuilder.WriteString(", ")
-						}
-						argsBuilder.WriteString("iface string, version uint32")
-						args++
-						msgArgsBuilder.WriteString(", iface, version")
-					}
+With -split file, each protocol is written to its own file in the output directory, next to client.go containing the
+client. With -split package, the core Wayland protocol is written to the package in the output directory, and every
+other protocol to its own package in a subdirectory named after the protocol, e.g. xdgshell for xdg_shell. Programs
+then only compile the protocols they import.
 
-					returnsBuilder.WriteString("" + toPascalCase(arg.Interface))
-					returnBuilder.WriteString("" + toCamelCase(arg.Name))
-					returns++
+Additional protocol roots, e.g. of wlroots or KDE, are added with -root namespace=directory. Their bindings are
+generated into a package named after the namespace in a subdirectory of the output, which imports the package of the
+other bindings. Patterns of -include and -exclude can be limited to a namespace with a "namespace:" prefix. The
+copyright notices of the protocols of a root are written to THIRD-PARTY-NOTICES in the directory of its package.
 
-					newsBuilder.WriteString("	" + toCamelCase(arg.Name) + " := ")
+An interface defined more than once with the same requests, events and enums is generated once. Differing definitions
+are an error, unless -collision selects the "first" or "last" definition in the order of the inputs, followed by the
+roots in the order of the flags.
 
-					if arg.Interface != "Object" {
-						newsBuilder.WriteString(toPascalCase(arg.Interface) + "(")
-					}
+Example for go:generate:
 
-					newsBuilder.WriteString("Object{\n")
-					newsBuilder.WriteString("		client: object.client,\n")
-					newsBuilder.WriteString("		id: object.client.NewObjectId(),\n")
-					newsBuilder.WriteString(`		iface: "` + arg.Interface + "\",\n")
-					newsBuilder.WriteString("	}")
+	//go:generate go run git.whizanth.com/go/wayland/scanner -o wayland.go -include 'wl_*,acme_*' wayland.xml acme.xml
 
-					if arg.Interface != "Object" {
-						newsBuilder.WriteString(")")
-					}
+Flags:
+`)
+	flags.PrintDefaults()
+}
 
-					newsBuilder.WriteString("\n\n")
+// config is the configuration of a scanner run, parsed from the command line
+type config struct {
+	output        string
+	outputDir     string
+	packageName   string
+	waylandImport string
+	importPath    string
+	split         string
+	collision     string
+	include       patterns
+	exclude       patterns
+	roots         roots
+	inputs        []string
+}
 
-					msgArgsBuilder.WriteString(", " + toCamelCase(arg.Name) + ".id")
-					newId++
+func main() {
+	conf, err := parseFlags(flag.CommandLine, os.Args[1:])
+	if err == nil {
+		err = conf.generate()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
 
-					continue
+// parseFlags parses the command line arguments following the program name into a config
+func parseFlags(flags *flag.FlagSet, args []string) (*config, error) {
+	conf := &config{packageName: os.Getenv("GOPACKAGE")}
+	if conf.packageName == "" {
+		conf.packageName = "wlclient"
+	}
 
-				}
+	flags.StringVar(&conf.output, "o", filepath.Join("wlclient", "generated.go"), "path of the generated Go file, or of the output directory if the bindings are split, by default wlclient when split")
+	flags.StringVar(&conf.packageName, "package", conf.packageName, "name of the generated Go package, defaults to $GOPACKAGE when run by go generate")
+	flags.StringVar(&conf.waylandImport, "wayland", "git.whizanth.com/go/wayland", "import path of the wayland package")
+	flags.StringVar(&conf.importPath, "import", "", "import path of the output directory, required to split the bindings into packages or to add protocol roots")
+	flags.StringVar(&conf.split, "split", "", `split the bindings into one "file" or one "package" per protocol`)
+	flags.StringVar(&conf.collision, "collision", "error", `how to handle differing definitions of an interface: "error", or use the "first" or "last" one`)
+	flags.Var(&conf.include, "include", "only generate interfaces matching the comma-separated glob patterns, can be repeated")
+	flags.Var(&conf.exclude, "exclude", "don't generate interfaces matching the comma-separated glob patterns, can be repeated")
+	flags.Var(&conf.roots, "root", "additional protocol root in the format namespace=directory, can be repeated")
+	flags.Usage = func() {
+		usage(flags)
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	conf.inputs = flags.Args()
+
+	if conf.split != "" && conf.split != "file" && conf.split != "package" {
+		return nil, fmt.Errorf("invalid value %q for -split, expected \"file\" or \"package\"", conf.split)
+	} else if conf.collision != "error" && conf.collision != "first" && conf.collision != "last" {
+		return nil, fmt.Errorf("invalid value %q for -collision, expected \"error\", \"first\" or \"last\"", conf.collision)
+	} else if (conf.split == "package" || len(conf.roots) > 0) && conf.importPath == "" {
+		return nil, errors.New("-import is required to split the bindings into packages or to add protocol roots")
+	}
 
-				if args > 0 {
-					argsBuilder.WriteString(", ")
-				}
+	conf.outputDir = conf.output
+	if conf.split != "" {
+		outputSet := false
+		flags.Visit(func(f *flag.Flag) {
+			outputSet = outputSet || f.Name == "o"
+		})
+		if !outputSet {
+			conf.outputDir = "wlclient"
+		}
+	} else {
+		conf.outputDir = filepath.Dir(conf.output)
+	}
+	return conf, nil
+}
 
-				argsBuilder.WriteString(toCamelCase(arg.Name) + " ")
-
-				if arg.Type == "string" {
-					argsBuilder.WriteString("string")
-				} else if arg.Type == "uint" {
-					argsBuilder.WriteString("uint32")
-				} else if arg.Type == "int" {
-					argsBuilder.WriteString("int32")
-				} else if arg.Type == "enum" {
-					argsBuilder.WriteString("uint32")
-				} else if arg.Type == "object" {
-					argsBuilder.WriteString(toPascalCase(arg.Interface))
-				} else if arg.Type == "fixed" {
-					argsBuilder.WriteString("wayland.Fixed")
-				} else if arg.Type == "array" {
-					argsBuilder.WriteString("[]uint32")
-				} else if arg.Type == "fd" {
-					argsBuilder.WriteString("int")
-
-					if fd > 0 {
-						fdBuilder.WriteString(", ")
-					}
-
-					fdBuilder.WriteString(toCamelCase(arg.Name))
-					fd++
-					args++
-					continue
-				} else {
-					fmt.Println("(!) unsupported type: " + arg.Type)
-				}
+// generate reads the inputs and writes the bindings configured by conf
+func (conf *config) generate() error {
+	inputs, err := loadInputs(conf.inputs, conf.roots)
+	if err != nil {
+		return err
+	}
 
-				msgArgsBuilder.WriteString(", " + toCamelCase(arg.Name))
-				if arg.Type == "object" {
-					msgArgsBuilder.WriteString(".id")
-				}
+	// Resolve interfaces defined more than once in the order of the inputs, so the result doesn't depend on anything
+	// but the command line
+	type definition struct {
+		input *protocolInput
+		iface Interface
+	}
+	definitions := make(map[string]definition)
+	for _, input := range inputs {
+		for _, iface := range input.proto.Interfaces {
+			if (len(conf.include) > 0 && !conf.include.match(input.namespace, iface.Name)) || conf.exclude.match(input.namespace, iface.Name) {
+				continue
+			}
 
-				args++
+			previous, ok := definitions[iface.Name]
+			if ok && wireFormat(previous.iface) == wireFormat(iface) {
+				continue
+			} else if ok && conf.collision == "first" {
+				fmt.Printf("(!) %s is defined differently by %s and %s, using the first definition\n", iface.Name, previous.input.source(), input.source())
+				continue
+			} else if ok && conf.collision == "last" {
+				fmt.Printf("(!) %s is defined differently by %s and %s, using the last definition\n", iface.Name, previous.input.source(), input.source())
+				previous.input.ifaces = slices.DeleteFunc(previous.input.ifaces, func(other Interface) bool { return other.Name == iface.Name })
+			} else if ok {
+				return fmt.Errorf("%s is defined differently by %s and %s, exclude one of them or use -collision", iface.Name, previous.input.source(), input.source())
 			}
 
-			builder.WriteString("func (object " + toPascalCase(iface.Name) + ") " + toPascalCase(request.Name) + "(")
-			builder.WriteString(argsBuilder.String())
-			builder.WriteString(") ")
+			definitions[iface.Name] = definition{input, iface}
+			input.ifaces = append(input.ifaces, iface)
+		}
+	}
 
-			if returns == 1 {
-				builder.WriteString(returnsBuilder.String() + " ")
-			} else if returns > 1 {
-				builder.WriteString("(" + returnsBuilder.String() + ") ")
-			}
+	core := &goPackage{name: conf.packageName, importPath: conf.importPath, core: true}
+	gen := newGenerator(conf.waylandImport, core)
 
-			builder.WriteString("{\n")
-			builder.WriteString(newsBuilder.String())
+	runtimePath := conf.output
+	if conf.split != "" {
+		runtimePath = filepath.Join(conf.outputDir, "client.go")
+	}
+	runtimeFile := gen.file(runtimePath, core)
+	runtimeFile.runtime = true
 
-			if fd > 0 {
-				builder.WriteString("	object.client.Write(wayland.NewMessage(object.id, " + strconv.Itoa(opCode) + msgArgsBuilder.String() + ").WithFds(" + fdBuilder.String() + "))\n")
-			} else {
-				builder.WriteString("	object.client.Write(wayland.NewMessage(object.id, " + strconv.Itoa(opCode) + msgArgsBuilder.String() + "))\n")
+	namespaces := make(map[string]*goPackage)
+	packages := make(map[string]string)
+	for _, input := range inputs {
+		if len(input.ifaces) == 0 {
+			continue
+		}
+
+		protocolName := input.proto.Name
+		if protocolName == "" {
+			protocolName = strings.TrimSuffix(filepath.Base(input.file), ".xml")
+		}
+		fileName := strings.ReplaceAll(protocolName, "_", "-") + ".go"
+
+		dir, pkg := conf.outputDir, core
+		if input.namespace != "" {
+			dir = filepath.Join(conf.outputDir, input.namespace)
+			if pkg = namespaces[input.namespace]; pkg == nil {
+				pkg = &goPackage{name: input.namespace, importPath: path.Join(conf.importPath, input.namespace)}
+				namespaces[input.namespace] = pkg
 			}
+		}
 
-			if returns > 0 {
-				builder.WriteString("\n")
-				builder.WriteString("	return " + returnBuilder.String() + "\n")
+		var f *goFile
+		switch {
+		case conf.split == "" && pkg == core:
+			f = runtimeFile
+		case conf.split == "":
+			f = gen.file(filepath.Join(dir, filepath.Base(conf.output)), pkg)
+		case conf.split == "file" || (pkg == core && slices.ContainsFunc(input.ifaces, func(iface Interface) bool { return iface.Name == "wl_display" })):
+			f = gen.file(filepath.Join(dir, fileName), pkg)
+		default:
+			name := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(protocolName))
+			importPath := path.Join(pkg.importPath, name)
+			if other, ok := packages[importPath]; ok {
+				return fmt.Errorf("protocols %s and %s would both be generated into package %s", other, input.source(), importPath)
 			}
+			packages[importPath] = input.source()
+
+			pkg = &goPackage{name: name, importPath: importPath}
+			f = gen.file(filepath.Join(dir, name, fileName), pkg)
+			f.doc = "Package " + name + " contains the bindings of the " + protocolName + " protocol, which build on the bindings of package " + conf.packageName + "."
+		}
+
+		if input.namespace != "" && pkg == namespaces[input.namespace] && !slices.ContainsFunc(gen.files, func(other *goFile) bool { return other.pkg == pkg && other.doc != "" }) {
+			f.doc = "Package " + pkg.name + " contains the bindings of the protocols of the " + pkg.name + " protocol root, which build on the bindings of package " + conf.packageName + "."
+		}
+
+		for _, iface := range input.ifaces {
+			gen.add(f, iface)
+		}
+	}
+
+	for _, name := range []string{"wl_display", "wl_registry"} {
+		if f, ok := gen.ifaces[name]; !ok || !f.pkg.core {
+			return fmt.Errorf("%s is required, but missing from the inputs or excluded", name)
+		}
+	}
+
+	if err := gen.generate(); err != nil {
+		return err
+	}
+	return writeNotices(conf.outputDir, inputs)
+}
+
+// writeNotices writes the copyright notices of the protocols of each protocol root to THIRD-PARTY-NOTICES in the
+// directory of its package, since the bindings are derived from protocols distributed under the terms of their own
+// projects, e.g. the LGPL of some KDE protocols. Roots without any notices are skipped.
+func writeNotices(outputDir string, inputs []*protocolInput) error {
+	var namespaces []string
+	notices := make(map[string][]string)
+	for _, input := range inputs {
+		notice := copyrightNotice(input.proto.Copyright)
+		if input.namespace == "" || len(input.ifaces) == 0 || notice == "" {
+			continue
+		}
+
+		if _, ok := notices[input.namespace]; !ok {
+			namespaces = append(namespaces, input.namespace)
+		}
+		notices[input.namespace] = append(notices[input.namespace], filepath.Base(input.file)+":\n\n"+notice)
+	}
+
+	for _, namespace := range namespaces {
+		text := "The bindings of this package are derived from the following protocols, distributed under the following terms:\n\n" + strings.Join(notices[namespace], "\n\n---\n\n") + "\n"
+		if err := os.WriteFile(filepath.Join(outputDir, namespace, "THIRD-PARTY-NOTICES"), []byte(text), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyrightNotice returns the copyright element of a protocol without the indentation of the XML and surrounding
+// blank lines
+func copyrightNotice(copyright string) string {
+	lines := strings.Split(strings.TrimRight(copyright, " \t\n"), "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if width := len(line) - len(strings.TrimLeft(line, " \t")); indent < 0 || width < indent {
+			indent = width
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent {
+			lines[i] = strings.TrimRight(line[indent:], " \t")
+		} else {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// loadInputs reads the protocols of the input files, followed by those of the additional protocol roots
+func loadInputs(args []string, protocolRoots roots) ([]*protocolInput, error) {
+	files, err := inputFiles(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []*protocolInput
+	for _, file := range files {
+		inputs = append(inputs, &protocolInput{file: file})
+	}
+	for _, root := range protocolRoots {
+		files, err := inputFiles([]string{root.dir})
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			inputs = append(inputs, &protocolInput{namespace: root.namespace, file: file})
+		}
+	}
+
+	for _, input := range inputs {
+		if input.proto, err = loadProtocol(input.file); err != nil {
+			return nil, err
+		}
+	}
+	return inputs, nil
+}
 
-			builder.WriteString("}\n")
+// wireFormat returns a description of everything about an interface that affects the generated bindings, apart from
+// documentation, to compare definitions of the same interface
+func wireFormat(iface Interface) string {
+	var builder strings.Builder
+	builder.WriteString(iface.Name + " " + strconv.Itoa(iface.Version) + "\n")
+	for _, methods := range [][]Method{iface.Requests, iface.Events} {
+		for _, method := range methods {
+			builder.WriteString(method.Name + " " + method.Type + " " + strconv.Itoa(method.Since) + " " + signature(method))
+			for _, arg := range method.Args {
+				builder.WriteString(" " + arg.Name + ":" + arg.Interface + ":" + arg.Enum)
+			}
 			builder.WriteString("\n")
 		}
+	}
+	for _, enum := range iface.Enums {
+		builder.WriteString(enum.Name + " " + strconv.FormatBool(enum.Bitfield))
+		for _, entry := range enum.Entries {
+			builder.WriteString(" " + entry.Name + "=" + entry.Value)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
 
-		for opCode, event := range iface.Events {
-			var args1Builder strings.Builder
-			var args2Builder strings.Builder
+// inputFiles returns the XML files to generate bindings for, in order. Directories are searched recursively, and the
+// default inputs are used if args is empty.
+func inputFiles(args []string) ([]string, error) {
+	if len(args) == 0 {
+		args = []string{filepath.Join("wayland", "protocol", "wayland.xml")}
+		for _, protocolStage := range []string{"stable", "staging", "experimental", "unstable"} {
+			dir := filepath.Join("wayland-protocols", protocolStage)
+			if _, err := os.Stat(dir); err == nil {
+				args = append(args, dir)
+			}
+		}
+	}
 
-			args := 0
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
 
-			for _, arg := range event.Args {
-				if args > 0 {
-					args1Builder.WriteString(", ")
-					args2Builder.WriteString(", ")
-				}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		err = filepath.WalkDir(arg, func(path string, entry fs.DirEntry, err error) error {
+			if err == nil && !entry.IsDir() && strings.HasSuffix(entry.Name(), ".xml") {
+				files = append(files, path)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// loadProtocol reads and unmarshals a protocol XML file
+func loadProtocol(xmlPath string) (Protocol, error) {
+	var proto Protocol
+
+	content, err := os.ReadFile(xmlPath)
+	if err != nil {
+		return proto, fmt.Errorf("unable to read %s: %w", xmlPath, err)
+	}
 
-				args1Builder.WriteString(toCamelCase(arg.Name) + " ")
-
-				if arg.Type == "string" {
-					args1Builder.WriteString("string")
-					args2Builder.WriteString("message.ReadString()")
-				} else if arg.Type == "uint" {
-					args1Builder.WriteString("uint32")
-					args2Builder.WriteString("message.ReadUint32()")
-				} else if arg.Type == "int" || arg.Type == "enum" {
-					args1Builder.WriteString("int32")
-					args2Builder.WriteString("message.ReadInt32()")
-				} else if arg.Type == "fixed" {
-					args1Builder.WriteString("wayland.Fixed")
-					args2Builder.WriteString("message.ReadFixed()")
-				} else if arg.Type == "object" {
-					if arg.Interface != "" {
-						args1Builder.WriteString(toPascalCase(arg.Interface))
-						args2Builder.WriteString(toPascalCase(arg.Interface) + "(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()})")
-					} else {
-						args1Builder.WriteString("Object")
-						args2Builder.WriteString("Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}")
-					}
-				} else if arg.Type == "fd" {
-					args1Builder.WriteString("int")
-					args2Builder.WriteString("message.ReadFd()")
-				} else if arg.Type == "array" {
-					args1Builder.WriteString("[]uint32")
-					args2Builder.WriteString("message.ReadArray()")
-				} else if arg.Type == "new_id" {
-					args1Builder.WriteString(toPascalCase(arg.Interface))
-					args2Builder.WriteString(toPascalCase(arg.Interface) + `(Object{client: object.client, id: object.client.NewObjectId(), iface: "` + arg.Interface + `"})`)
+	if err := xml.Unmarshal(content, &proto); err != nil {
+		return proto, fmt.Errorf("unable to unmarshal %s: %w", xmlPath, err)
+	}
+	return proto, nil
+}
+
+// writeComment writes text as a Go comment wrapped at maxCommentWidth. Blank lines separate paragraphs, lines starting
+// with "-" or "*" are kept as list items, everything else is reflowed.
+func writeComment(builder *strings.Builder, indent string, text string) {
+	var paragraphs [][]string
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			paragraphs = append(paragraphs, paragraph)
+			paragraph = nil
+		}
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			flush()
+		} else if len(paragraph) > 0 && !strings.HasPrefix(line, "- ") && !strings.HasPrefix(line, "* ") {
+			paragraph[len(paragraph)-1] += " " + line
+		} else {
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+
+	for i, paragraph := range paragraphs {
+		if i > 0 {
+			builder.WriteString(indent + "//\n")
+		}
+
+		for _, item := range paragraph {
+			prefix, rest := indent+"// ", indent+"// "
+			if strings.HasPrefix(item, "- ") || strings.HasPrefix(item, "* ") {
+				prefix, rest = indent+"//   - ", indent+"//     "
+				item = item[2:]
+			}
+
+			line := prefix
+			for j, word := range strings.Fields(item) {
+				if j > 0 && len(line)+1+len(word) > maxCommentWidth {
+					builder.WriteString(line + "\n")
+					line = rest + word
+				} else if j > 0 {
+					line += " " + word
+				} else {
+					line += word
 				}
+			}
+			builder.WriteString(line + "\n")
+		}
+	}
+}
 
-				args++
+// description returns the summary of a description as first sentence, followed by the full text, e.g.
+// "WlSurface is the wl_surface interface: an onscreen surface"
+func description(intro string, description Description) string {
+	text := intro
+	if summary := strings.TrimSpace(description.Summary); summary != "" {
+		text += ": " + summary
+	}
+	if full := strings.TrimSpace(description.Full); full != "" {
+		text += "\n\n" + full
+	}
+	return text
+}
+
+// methodComment returns the doc comment of the function generated for a request or event, including the summaries of
+// its arguments, which of them can be null and the version it was introduced or deprecated in
+func methodComment(iface Interface, intro string, method Method) string {
+	text := description(intro, method.Description)
+
+	var args []string
+	for _, arg := range method.Args {
+		summary := strings.TrimSpace(arg.Summary)
+		if arg.AllowNull && (arg.Type == "string" || arg.Type == "object") {
+			summary = strings.TrimSuffix(summary, ".")
+			if summary != "" {
+				summary += ", "
 			}
+			summary += "nil for null"
+		}
+		if summary != "" {
+			args = append(args, "- "+toCamelCase(arg.Name)+": "+summary)
+		}
+	}
+	if len(args) > 0 {
+		text += "\n\nArguments:\n" + strings.Join(args, "\n")
+	}
 
-			builder.WriteString("func (object " + toPascalCase(iface.Name) + ") On" + toPascalCase(event.Name) + "(listener func(")
-			builder.WriteString(args1Builder.String())
-			builder.WriteString(")) chan struct{} {\n")
-			builder.WriteString("	return object.client.On(object.id, " + strconv.Itoa(opCode) + ", func(message *wayland.Message) {\n")
-			builder.WriteString("		listener(" + args2Builder.String() + ")\n")
-			builder.WriteString("	})\n")
-			builder.WriteString("}\n")
-			builder.WriteString("\n")
+	if method.Type == "destructor" {
+		text += "\n\nThis is a destructor, after which the object can no longer be used."
+	}
+	if method.Since > 1 {
+		text += "\n\nAvailable since version " + strconv.Itoa(method.Since) + " of " + iface.Name + "."
+	}
+	if method.DeprecatedSince > 0 {
+		text += "\n\nDeprecated: " + iface.Name + "." + method.Name + " is deprecated since version " + strconv.Itoa(method.DeprecatedSince) + " of " + iface.Name + "."
+	}
+	return text
+}
+
+// newInterfaces returns the quoted interface names of the new_id arguments of a method, in the order of its signature
+func newInterfaces(method Method) []string {
+	var result []string
+	for _, arg := range method.Args {
+		if arg.Type == "new_id" {
+			result = append(result, strconv.Quote(arg.Interface))
+		}
+	}
+	return result
+}
+
+// signature returns the argument types of a method in the format used by wayland.Method
+func signature(method Method) string {
+	var builder strings.Builder
+	for _, arg := range method.Args {
+		if arg.AllowNull && (arg.Type == "string" || arg.Type == "object") {
+			builder.WriteString("?")
+		}
+
+		switch arg.Type {
+		case "int", "enum":
+			builder.WriteString("i")
+		case "uint":
+			builder.WriteString("u")
+		case "fixed":
+			builder.WriteString("f")
+		case "string":
+			builder.WriteString("s")
+		case "object":
+			builder.WriteString("o")
+		case "new_id":
+			if arg.Interface == "" {
+				builder.WriteString("su")
+			}
+			builder.WriteString("n")
+		case "array":
+			builder.WriteString("a")
+		case "fd":
+			builder.WriteString("h")
 		}
 	}
+	return builder.String()
+}
+
+// enumType returns the name of the Go type generated for an enum referenced by an argument of iface, either as
+// "enum" within the same interface or as "interface.enum"
+func enumType(iface string, ref string) string {
+	if before, after, ok := strings.Cut(ref, "."); ok {
+		return toPascalCase(before) + toPascalCase(after)
+	}
+	return toPascalCase(iface) + toPascalCase(ref)
 }
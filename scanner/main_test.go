@@ -0,0 +1,557 @@
+package main
+
+import (
+	"flag"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// protocolDirs returns the number of interfaces defined by the XML files of each directory containing any
+func protocolDirs(t *testing.T, files []string) map[string]int {
+	t.Helper()
+
+	dirs := make(map[string]int)
+	for _, file := range files {
+		proto, err := loadProtocol(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dirs[filepath.Dir(file)] += len(proto.Interfaces)
+	}
+	return dirs
+}
+
+func writeProtocol(t *testing.T, file string, ifaces ...string) {
+	t.Helper()
+
+	var builder strings.Builder
+	builder.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	builder.WriteString(`<protocol name="` + strings.TrimSuffix(filepath.Base(file), ".xml") + `">` + "\n")
+	for _, iface := range ifaces {
+		builder.WriteString(`  <interface name="` + iface + `" version="1"/>` + "\n")
+	}
+	builder.WriteString("</protocol>\n")
+
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, []byte(builder.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDefaultInputs(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	writeProtocol(t, filepath.Join("wayland", "protocol", "wayland.xml"), "wl_display", "wl_registry")
+	writeProtocol(t, filepath.Join("wayland-protocols", "stable", "xdg-shell", "xdg-shell.xml"), "xdg_wm_base")
+	writeProtocol(t, filepath.Join("wayland-protocols", "staging", "fifo", "fifo-v1.xml"), "wp_fifo_manager_v1")
+	writeProtocol(t, filepath.Join("wayland-protocols", "unstable", "xdg-decoration", "xdg-decoration-unstable-v1.xml"), "zxdg_decoration_manager_v1")
+	writeProtocol(t, filepath.Join("wayland-protocols", "unstable", "xdg-foreign", "xdg-foreign-unstable-v1.xml"), "zxdg_exporter_v1")
+	writeProtocol(t, filepath.Join("wayland-protocols", "unstable", "xdg-foreign", "xdg-foreign-unstable-v2.xml"), "zxdg_exporter_v2")
+	if err := os.WriteFile(filepath.Join("wayland-protocols", "unstable", "xdg-foreign", "README"), []byte("xdg foreign protocol\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := inputFiles(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dirs := protocolDirs(t, files)
+	for _, dir := range []string{
+		filepath.Join("wayland", "protocol"),
+		filepath.Join("wayland-protocols", "stable", "xdg-shell"),
+		filepath.Join("wayland-protocols", "staging", "fifo"),
+		filepath.Join("wayland-protocols", "unstable", "xdg-decoration"),
+		filepath.Join("wayland-protocols", "unstable", "xdg-foreign"),
+	} {
+		if dirs[dir] == 0 {
+			t.Errorf("no interfaces found in %s", dir)
+		}
+	}
+	if dirs[filepath.Join("wayland-protocols", "unstable", "xdg-foreign")] != 2 {
+		t.Errorf("expected both versions of xdg-foreign, found %d interfaces", dirs[filepath.Join("wayland-protocols", "unstable", "xdg-foreign")])
+	}
+}
+
+// TestProtocolDirectories fails if any protocol directory of a wayland-protocols tree yields no interfaces. The
+// fixture in testdata mirrors the layout of every stage, and a checkout is additionally tested if found at
+// $WAYLAND_PROTOCOLS, or next to the scanner like when generating the bindings.
+func TestProtocolDirectories(t *testing.T) {
+	checkout := os.Getenv("WAYLAND_PROTOCOLS")
+	if checkout == "" {
+		checkout = filepath.Join("..", "wayland-protocols")
+	}
+
+	for _, root := range []string{filepath.Join("testdata", "wayland-protocols"), checkout} {
+		t.Run(root, func(t *testing.T) {
+			if _, err := os.Stat(root); err != nil {
+				t.Skipf("no wayland-protocols checkout at %s", root)
+			}
+
+			found := 0
+			for _, protocolStage := range []string{"stable", "staging", "experimental", "unstable"} {
+				dirs, err := os.ReadDir(filepath.Join(root, protocolStage))
+				if os.IsNotExist(err) {
+					continue
+				} else if err != nil {
+					t.Fatal(err)
+				}
+
+				for _, dir := range dirs {
+					if !dir.IsDir() {
+						continue
+					}
+
+					files, err := inputFiles([]string{filepath.Join(root, protocolStage, dir.Name())})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if protocolDirs(t, files)[filepath.Join(root, protocolStage, dir.Name())] == 0 {
+						t.Errorf("no interfaces found in %s/%s", protocolStage, dir.Name())
+					}
+					found++
+				}
+			}
+			if found == 0 {
+				t.Fatalf("no protocol directories found in %s", root)
+			}
+		})
+	}
+}
+
+// runScanner parses args like the command line and generates the bindings
+func runScanner(args ...string) error {
+	flags := flag.NewFlagSet("scanner", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	conf, err := parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	return conf.generate()
+}
+
+// outputDir changes to a new directory inside testdata, so the bindings generated into it can be built with the
+// wayland package of this module, and returns the import path of the directory
+func outputDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("testdata", "output-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	t.Chdir(dir)
+	return "git.whizanth.com/go/wayland/scanner/testdata/" + filepath.Base(dir)
+}
+
+// fixture returns the absolute path of a file or directory in testdata
+func fixture(t *testing.T, name string) string {
+	t.Helper()
+
+	result, err := filepath.Abs(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// generatedFiles returns the package names of the files in the current directory by their path, which is empty for
+// files other than Go files
+func generatedFiles(t *testing.T) map[string]string {
+	t.Helper()
+
+	files := make(map[string]string)
+	err := filepath.WalkDir(".", func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		if filepath.Ext(file) != ".go" {
+			files[filepath.ToSlash(file)] = ""
+			return nil
+		}
+		parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(file)] = parsed.Name.Name
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestParseFlags(t *testing.T) {
+	t.Setenv("GOPACKAGE", "")
+
+	for _, test := range []struct {
+		name        string
+		args        []string
+		outputDir   string
+		packageName string
+		err         string
+	}{
+		{"defaults", nil, "wlclient", "wlclient", ""},
+		{"output file", []string{"-o", "bindings/wayland.go", "-package", "bindings"}, "bindings", "bindings", ""},
+		{"split without output", []string{"-split", "file"}, "wlclient", "wlclient", ""},
+		{"split with output", []string{"-split", "file", "-o", "bindings"}, "bindings", "wlclient", ""},
+		{"split package", []string{"-split", "package", "-import", "example.com/wlclient"}, "wlclient", "wlclient", ""},
+		{"unknown split", []string{"-split", "protocol"}, "", "", `invalid value "protocol" for -split`},
+		{"unknown collision", []string{"-collision", "merge"}, "", "", `invalid value "merge" for -collision`},
+		{"split package without import", []string{"-split", "package"}, "", "", "-import is required"},
+		{"root without import", []string{"-root", "acme=acme"}, "", "", "-import is required"},
+		{"root without directory", []string{"-root", "acme"}, "", "", "expected namespace=directory"},
+		{"invalid namespace", []string{"-root", "Acme=acme"}, "", "", "expected a lowercase Go package name"},
+		{"repeated namespace", []string{"-import", "example.com/wlclient", "-root", "acme=acme", "-root", "acme=other"}, "", "", "used more than once"},
+		{"invalid pattern", []string{"-include", "wl_*,["}, "", "", "invalid pattern"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			flags := flag.NewFlagSet("scanner", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			conf, err := parseFlags(flags, test.args)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("got error %v, expected %q", err, test.err)
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if conf.outputDir != test.outputDir || conf.packageName != test.packageName {
+				t.Fatalf("got output directory %q and package %q, expected %q and %q", conf.outputDir, conf.packageName, test.outputDir, test.packageName)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Setenv("GOPACKAGE", "")
+	core := fixture(t, filepath.Join("wayland", "protocol", "wayland.xml"))
+	protocols := fixture(t, "wayland-protocols")
+	stable := filepath.Join(protocols, "stable")
+	staging := filepath.Join(protocols, "staging")
+	acme := "acme=" + fixture(t, "acme")
+
+	for _, test := range []struct {
+		name string
+		// args are passed to the scanner, with {import} replaced by the import path of the output directory
+		args []string
+		// files are the package names of the generated files by their path, empty for files other than Go files
+		files map[string]string
+		// contains lists text expected in generated files by their path
+		contains map[string][]string
+		// omits lists text expected in none of the generated files
+		omits []string
+		err   string
+	}{
+		{
+			name:     "single file",
+			args:     []string{core, stable, staging},
+			files:    map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{"wlclient/generated.go": {"type WlSurface ", "type TestToplevel "}},
+		},
+		{
+			name:     "every stage",
+			args:     []string{core, protocols},
+			files:    map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{"wlclient/generated.go": {"type ZtestExporterV1 ", "type ZtestExporterV2 ", "type ZtestInhibitManagerV1 ", "type XxTestManagerV1 "}},
+		},
+		{
+			name:  "enum versions",
+			args:  []string{core, stable, staging},
+			files: map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{
+				"wlclient/generated.go": {"// Available since version 2 of test_toplevel.\n\tTestToplevelStateTiled ", "if since := state.since(); since > 1 {"},
+			},
+			// Entries of enums of other interfaces refer to the version of that interface
+			omits: []string{"transform.since()"},
+		},
+		{
+			name:     "output and package",
+			args:     []string{"-o", "bindings/wayland.go", "-package", "bindings", core},
+			files:    map[string]string{"bindings/wayland.go": "bindings"},
+			contains: map[string][]string{"bindings/wayland.go": {"type WlOutput "}},
+		},
+		{
+			name:     "include",
+			args:     []string{"-include", "wl_display,wl_registry", "-include", "wl_callback", core, stable, staging},
+			files:    map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{"wlclient/generated.go": {"type WlCallback "}},
+			omits:    []string{"type WlSurface ", "type TestWmBase "},
+		},
+		{
+			name:     "exclude",
+			args:     []string{"-exclude", "test_wm_*", core, stable, staging},
+			files:    map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{"wlclient/generated.go": {"type TestToplevel "}},
+			omits:    []string{"type TestWmBase "},
+		},
+		{
+			name:  "root",
+			args:  []string{"-import", "{import}/wlclient", "-root", acme, core, stable, staging},
+			files: map[string]string{"wlclient/generated.go": "wlclient", "wlclient/acme/generated.go": "acme", "wlclient/acme/THIRD-PARTY-NOTICES": ""},
+			contains: map[string][]string{
+				"wlclient/acme/generated.go": {`"{import}/wlclient"`, "type AcmePanel ", "toplevel wlclient.TestToplevel"},
+				"wlclient/acme/THIRD-PARTY-NOTICES": {
+					"acme-dock.xml:\n\nTest fixture of a second protocol of the same root.\n",
+					"acme-panel.xml:\n\nTest fixture of a protocol root outside of wayland-protocols.\n",
+				},
+			},
+		},
+		{
+			name:  "include of namespace",
+			args:  []string{"-import", "{import}/wlclient", "-root", acme, "-include", "wl_*,acme:*", core, stable, staging},
+			files: map[string]string{"wlclient/generated.go": "wlclient", "wlclient/acme/generated.go": "acme", "wlclient/acme/THIRD-PARTY-NOTICES": ""},
+			contains: map[string][]string{
+				"wlclient/acme/generated.go": {"type AcmePanelManager ", "toplevel wlclient.Object"},
+			},
+			omits: []string{"type TestToplevel "},
+		},
+		{
+			name:     "exclude of namespace",
+			args:     []string{"-import", "{import}/wlclient", "-root", acme, "-exclude", "acme:acme_panel_manager,test_*", core, stable, staging},
+			files:    map[string]string{"wlclient/generated.go": "wlclient", "wlclient/acme/generated.go": "acme", "wlclient/acme/THIRD-PARTY-NOTICES": ""},
+			contains: map[string][]string{"wlclient/acme/generated.go": {"type AcmePanel "}},
+			omits:    []string{"type AcmePanelManager ", "type TestWmBase "},
+		},
+		{
+			name: "split file",
+			args: []string{"-split", "file", core, stable, staging},
+			files: map[string]string{
+				"wlclient/client.go":             "wlclient",
+				"wlclient/wayland.go":            "wlclient",
+				"wlclient/test-shell.go":         "wlclient",
+				"wlclient/test-decoration-v1.go": "wlclient",
+			},
+			contains: map[string][]string{
+				"wlclient/client.go":             {"func Bind["},
+				"wlclient/test-decoration-v1.go": {"toplevel TestToplevel"},
+			},
+		},
+		{
+			name:  "split file with output",
+			args:  []string{"-split", "file", "-o", "bindings", "-package", "bindings", core},
+			files: map[string]string{"bindings/client.go": "bindings", "bindings/wayland.go": "bindings"},
+		},
+		{
+			name: "split package",
+			args: []string{"-split", "package", "-import", "{import}/wlclient", core, stable, staging},
+			files: map[string]string{
+				"wlclient/client.go":                              "wlclient",
+				"wlclient/wayland.go":                             "wlclient",
+				"wlclient/testshell/test-shell.go":                "testshell",
+				"wlclient/testdecorationv1/test-decoration-v1.go": "testdecorationv1",
+			},
+			contains: map[string][]string{
+				"wlclient/testshell/test-shell.go":                {`"{import}/wlclient"`, "surface wlclient.WlSurface", "transform wlclient.WlOutputTransform"},
+				"wlclient/testdecorationv1/test-decoration-v1.go": {`"{import}/wlclient/testshell"`, "toplevel testshell.TestToplevel"},
+			},
+		},
+		{
+			name: "split file with root",
+			args: []string{"-split", "file", "-import", "{import}/wlclient", "-root", acme, core, stable, staging},
+			files: map[string]string{
+				"wlclient/client.go":                "wlclient",
+				"wlclient/wayland.go":               "wlclient",
+				"wlclient/test-shell.go":            "wlclient",
+				"wlclient/test-decoration-v1.go":    "wlclient",
+				"wlclient/acme/acme-dock.go":        "acme",
+				"wlclient/acme/acme-panel.go":       "acme",
+				"wlclient/acme/THIRD-PARTY-NOTICES": "",
+			},
+			// Both files contain bitfield enums, but only the first one declares the enum runtime of the package
+			contains: map[string][]string{
+				"wlclient/acme/acme-dock.go":  {"type enumEntry ", "func bitfieldString("},
+				"wlclient/acme/acme-panel.go": {"toplevel wlclient.TestToplevel"},
+			},
+		},
+		{
+			name: "split package with root",
+			args: []string{"-split", "package", "-import", "{import}/wlclient", "-root", acme, core, stable, staging},
+			files: map[string]string{
+				"wlclient/client.go":                              "wlclient",
+				"wlclient/wayland.go":                             "wlclient",
+				"wlclient/testshell/test-shell.go":                "testshell",
+				"wlclient/testdecorationv1/test-decoration-v1.go": "testdecorationv1",
+				"wlclient/acme/acmedock/acme-dock.go":             "acmedock",
+				"wlclient/acme/acmepanel/acme-panel.go":           "acmepanel",
+				"wlclient/acme/THIRD-PARTY-NOTICES":               "",
+			},
+			contains: map[string][]string{
+				"wlclient/acme/acmepanel/acme-panel.go": {`"{import}/wlclient/testshell"`, "toplevel testshell.TestToplevel", "output *wlclient.WlOutput"},
+			},
+		},
+		{
+			name:  "cycle within a package",
+			args:  []string{"-split", "file", core, fixture(t, "cycle")},
+			files: map[string]string{"wlclient/client.go": "wlclient", "wlclient/wayland.go": "wlclient", "wlclient/test-cycle-a.go": "wlclient", "wlclient/test-cycle-b.go": "wlclient"},
+		},
+		{
+			name: "cycle between packages",
+			args: []string{"-split", "package", "-import", "{import}/wlclient", core, fixture(t, "cycle")},
+			err:  "import cycle between generated packages",
+		},
+		{
+			name: "collision",
+			args: []string{core, stable, fixture(t, "collision")},
+			err:  "test_toplevel is defined differently by " + filepath.Join(stable, "test-shell", "test-shell.xml") + " and " + filepath.Join(fixture(t, "collision"), "test-shell-fork.xml"),
+		},
+		{
+			name:     "first definition",
+			args:     []string{"-collision", "first", core, stable, fixture(t, "collision")},
+			files:    map[string]string{"wlclient/generated.go": "wlclient"},
+			contains: map[string][]string{"wlclient/generated.go": {"func (object TestToplevel) SetState("}},
+			omits:    []string{"SetFork"},
+		},
+		{
+			name:  "last definition",
+			args:  []string{"-split", "file", "-collision", "last", core, stable, fixture(t, "collision")},
+			files: map[string]string{"wlclient/client.go": "wlclient", "wlclient/wayland.go": "wlclient", "wlclient/test-shell.go": "wlclient", "wlclient/test-shell-fork.go": "wlclient"},
+			contains: map[string][]string{
+				"wlclient/test-shell.go":      {"type TestWmBase "},
+				"wlclient/test-shell-fork.go": {"type TestToplevel ", "func (object TestToplevel) SetFork("},
+			},
+			omits: []string{"SetState"},
+		},
+		{
+			name: "missing core interface",
+			args: []string{"-exclude", "wl_registry", core},
+			err:  "wl_registry is required",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			importPath := outputDir(t)
+			var args []string
+			for _, arg := range test.args {
+				args = append(args, strings.ReplaceAll(arg, "{import}", importPath))
+			}
+
+			err := runScanner(args...)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("got error %v, expected %q", err, test.err)
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			files := generatedFiles(t)
+			for file, pkg := range test.files {
+				if generated, ok := files[file]; !ok || generated != pkg {
+					t.Errorf("expected package %s in %s, got %q", pkg, file, files[file])
+				}
+			}
+			for file := range files {
+				if _, ok := test.files[file]; !ok {
+					t.Errorf("unexpected file %s", file)
+				}
+			}
+
+			for file := range files {
+				data, err := os.ReadFile(file)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, text := range test.contains[file] {
+					if text = strings.ReplaceAll(text, "{import}", importPath); !strings.Contains(string(data), text) {
+						t.Errorf("%s doesn't contain %q", file, text)
+					}
+				}
+				for _, text := range test.omits {
+					if strings.Contains(string(data), text) {
+						t.Errorf("%s contains %q", file, text)
+					}
+				}
+			}
+
+			if testing.Short() {
+				return
+			}
+			if output, err := exec.Command("go", "vet", "./...").CombinedOutput(); err != nil {
+				t.Fatalf("generated bindings don't compile: %v\n%s", err, output)
+			}
+		})
+	}
+}
+
+func TestGeneratedEnums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the generated bindings is slow")
+	}
+	t.Setenv("GOPACKAGE", "")
+	core := fixture(t, filepath.Join("wayland", "protocol", "wayland.xml"))
+	enums := fixture(t, "enums")
+	outputDir(t)
+	if err := runScanner(core, enums); err != nil {
+		t.Fatal(err)
+	}
+
+	// The expressions are evaluated by a test in the generated package, which compares their formatted value
+	var cases strings.Builder
+	for _, test := range []struct {
+		expr     string
+		expected string
+	}{
+		{"TestEnumsMode(0).String()", "none"},
+		{"TestEnumsModeFirst.String()", "first"},
+		{"TestEnumsModeAlias.String()", "first"},
+		{"TestEnumsModeSecond.String()", "second"},
+		{"TestEnumsMode(7).String()", "7"},
+		{"TestEnumsFlags(0).String()", "none"},
+		{"TestEnumsFlagsA.String()", "a"},
+		{"TestEnumsFlagsAlias.String()", "b"},
+		{"TestEnumsFlagsBoth.String()", "a|b"},
+		{"(TestEnumsFlagsA | 8).String()", "a|8"},
+		{"TestEnumsFlags(8).String()", "8"},
+		{"TestEnumsMask(0).String()", "0"},
+		{"(TestEnumsMaskX | TestEnumsMaskY).String()", "x|y"},
+		{"TestEnumsMask(2).String()", "2"},
+		{"TestEnumsMask(7).String()", "x|y|2"},
+		{"TestEnumsFlagsBoth.Has(TestEnumsFlagsA)", "true"},
+		{"TestEnumsFlagsA.Has(TestEnumsFlagsBoth)", "false"},
+		{"TestEnumsFlags(0).Has(TestEnumsFlagsNone)", "true"},
+		{"TestEnumsFlags(0).Has(TestEnumsFlagsA)", "false"},
+		{"(TestEnumsFlagsA | 8).Has(8)", "true"},
+		{"TestEnumsFlagsB.Has(TestEnumsFlagsAlias)", "true"},
+	} {
+		cases.WriteString("\t\t{" + strconv.Quote(test.expr) + ", fmt.Sprint(" + test.expr + "), " + strconv.Quote(test.expected) + "},\n")
+	}
+
+	source := `package wlclient
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEnumMethods(t *testing.T) {
+	for _, test := range []struct{ expr, got, expected string }{
+` + cases.String() + `	} {
+		if test.got != test.expected {
+			t.Errorf("%s is %q, expected %q", test.expr, test.got, test.expected)
+		}
+	}
+}
+`
+	if err := os.WriteFile(filepath.Join("wlclient", "enums_test.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if output, err := exec.Command("go", "test", "./...").CombinedOutput(); err != nil {
+		t.Fatalf("generated enums are formatted incorrectly: %v\n%s", err, output)
+	}
+}
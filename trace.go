@@ -0,0 +1,190 @@
+package wayland
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SetTracer logs every request sent and event received at debug level to handler, formatted like a call such as
+// xdg_toplevel#12.set_title("foo"). Each record has a "direction" attribute that is either "request" or "event".
+// Tracing is disabled if handler is nil, and enabled with NewDebugHandler writing to stderr by NewClient if
+// WAYLAND_DEBUG is set to 1 or client, like for libwayland.
+func (client *Client) SetTracer(handler slog.Handler) {
+	if handler == nil {
+		client.tracer.Store(nil)
+		return
+	}
+	client.tracer.Store(slog.New(handler))
+}
+
+// debugTracer returns the handler tracing is enabled with by default, which depends on WAYLAND_DEBUG
+func debugTracer() slog.Handler {
+	switch os.Getenv("WAYLAND_DEBUG") {
+	case "1", "client":
+		return NewDebugHandler(os.Stderr)
+	}
+	return nil
+}
+
+// trace logs a request or event if tracing is enabled
+func (client *Client) trace(msg *Message, event bool) {
+	tracer := client.tracer.Load()
+	if tracer == nil || !tracer.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+
+	direction := "request"
+	if event {
+		direction = "event"
+	}
+	tracer.LogAttrs(context.Background(), slog.LevelDebug, client.formatMessage(msg, event), slog.String("direction", direction))
+}
+
+// formatMessage formats a message as a call with decoded arguments, using the interface of the object it was sent to.
+// Messages of objects of unknown interface are formatted with their opcode and raw body, like malformed messages.
+func (client *Client) formatMessage(msg *Message, event bool) string {
+	id := strconv.FormatUint(uint64(msg.ObjectId), 10)
+
+	iface := client.objectInterface(msg.ObjectId)
+	methods := iface.requests()
+	if event {
+		methods = iface.events()
+	}
+	if int(msg.OpCode) >= len(methods) {
+		name := "unknown"
+		if iface != nil {
+			name = iface.Name
+		}
+		return fmt.Sprintf("%s#%s.%d(%x)", name, id, msg.OpCode, msg.Body)
+	}
+	method := methods[msg.OpCode]
+
+	args := &Message{ObjectId: msg.ObjectId, OpCode: msg.OpCode, Body: msg.Body, Fds: msg.Fds}
+	var formatted []string
+	nullable := false
+	newIds := 0
+	for _, argType := range method.Signature {
+		if argType == '?' {
+			nullable = true
+			continue
+		}
+
+		var arg string
+		switch argType {
+		case 'i':
+			arg = strconv.FormatInt(int64(args.ReadInt32()), 10)
+		case 'u':
+			arg = strconv.FormatUint(uint64(args.ReadUint32()), 10)
+		case 'f':
+			arg = strconv.FormatFloat(args.ReadFixed().Float64(), 'f', -1, 64)
+		case 's':
+			if value := args.ReadNullableString(); value == nil && nullable {
+				arg = "nil"
+			} else if value == nil {
+				arg = `""`
+			} else {
+				arg = strconv.Quote(*value)
+			}
+		case 'o':
+			arg = client.formatObject(args.ReadUint32())
+		case 'n':
+			// Objects created by events aren't registered yet when the event is traced
+			id := args.ReadUint32()
+			if newIds < len(method.NewInterfaces) && method.NewInterfaces[newIds] != "" {
+				arg = "new id " + method.NewInterfaces[newIds] + "#" + strconv.FormatUint(uint64(id), 10)
+			} else {
+				arg = "new id " + client.formatObject(id)
+			}
+			newIds++
+		case 'a':
+			arg = "array[" + strconv.Itoa(len(args.ReadArray())) + "]"
+		case 'h':
+			arg = "fd " + strconv.Itoa(args.ReadFd())
+		}
+		formatted = append(formatted, arg)
+		nullable = false
+	}
+	if args.Err() != nil {
+		return fmt.Sprintf("%s#%s.%s(malformed %x)", iface.Name, id, method.Name, msg.Body)
+	}
+
+	return iface.Name + "#" + id + "." + method.Name + "(" + strings.Join(formatted, ", ") + ")"
+}
+
+// formatObject formats an object argument as interface#id, or nil for the null object
+func (client *Client) formatObject(objectId uint32) string {
+	if objectId == 0 {
+		return "nil"
+	}
+
+	name := "unknown"
+	if iface := client.objectInterface(objectId); iface != nil {
+		name = iface.Name
+	}
+	return name + "#" + strconv.FormatUint(uint64(objectId), 10)
+}
+
+// requests returns the requests of the interface, which has none if it isn't known
+func (iface *Interface) requests() []Method {
+	if iface == nil {
+		return nil
+	}
+	return iface.Requests
+}
+
+// events returns the events of the interface, which has none if it isn't known
+func (iface *Interface) events() []Method {
+	if iface == nil {
+		return nil
+	}
+	return iface.Events
+}
+
+// debugHandler writes trace records in the format of libwayland's WAYLAND_DEBUG output
+type debugHandler struct {
+	mu *sync.Mutex
+	w  io.Writer
+}
+
+// NewDebugHandler returns a handler for SetTracer that writes one line per request or event to w, prefixed by a
+// timestamp and, for requests, an arrow:
+//
+//	[15:04:05.000000]  -> wl_display#1.get_registry(new id wl_registry#2)
+//	[15:04:05.000123] wl_registry#2.global(1, "wl_compositor", 6)
+func NewDebugHandler(w io.Writer) slog.Handler {
+	return &debugHandler{mu: &sync.Mutex{}, w: w}
+}
+
+func (handler *debugHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return true
+}
+
+func (handler *debugHandler) Handle(ctx context.Context, record slog.Record) error {
+	prefix := ""
+	record.Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "direction" && attr.Value.String() == "request" {
+			prefix = " -> "
+		}
+		return true
+	})
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	_, err := fmt.Fprintf(handler.w, "[%s] %s%s\n", record.Time.Format("15:04:05.000000"), prefix, record.Message)
+	return err
+}
+
+func (handler *debugHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return handler
+}
+
+func (handler *debugHandler) WithGroup(name string) slog.Handler {
+	return handler
+}
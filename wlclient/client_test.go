@@ -0,0 +1,242 @@
+package wlclient
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"git.whizanth.com/go/wayland"
+	"golang.org/x/sys/unix"
+)
+
+// newTestClient returns the bindings of a client connected to a raw client of the wayland package acting as the
+// compositor
+func newTestClient(t *testing.T) (*Client, *wayland.Client) {
+	t.Helper()
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewFromFd(fds[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := wayland.NewClientFromFd(fds[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return client, server
+}
+
+// readRequest reads the next request the client sent, failing unless it is the request of the specified object and
+// opcode
+func readRequest(t *testing.T, server *wayland.Client, objectId uint32, opcode uint16) *wayland.Message {
+	t.Helper()
+
+	msg, err := server.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.ObjectId != objectId || msg.OpCode != opcode {
+		t.Fatalf("got request %d of object %d, expected request %d of object %d", msg.OpCode, msg.ObjectId, opcode, objectId)
+	}
+	return msg
+}
+
+// getRegistry creates the registry of the client and reads its request
+func getRegistry(t *testing.T, client *Client, server *wayland.Client) WlRegistry {
+	t.Helper()
+
+	registry, err := client.GetDisplay().GetRegistry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	readRequest(t, server, client.GetDisplay().id, 1)
+	return registry
+}
+
+func TestBind(t *testing.T) {
+	client, server := newTestClient(t)
+	registry := getRegistry(t, client, server)
+	supported := interfaces["wl_compositor"].Version
+
+	for _, version := range []uint32{1, supported, supported + 10} {
+		compositor, err := Bind[WlCompositor](registry, 7, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := client.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		// The version is limited to the one supported by the bindings
+		expected := min(version, supported)
+		if compositor.version != expected {
+			t.Errorf("bound version %d of wl_compositor, expected version %d", compositor.version, expected)
+		}
+
+		msg := readRequest(t, server, registry.id, 0)
+		name, iface, bound, id := msg.ReadUint32(), msg.ReadString(), msg.ReadUint32(), msg.ReadUint32()
+		if name != 7 || iface != "wl_compositor" || bound != expected || id != compositor.id {
+			t.Errorf("sent bind(%d, %q, %d, %d), expected bind(7, \"wl_compositor\", %d, %d)", name, iface, bound, id, expected, compositor.id)
+		}
+	}
+}
+
+func TestBindUnknownInterface(t *testing.T) {
+	client, server := newTestClient(t)
+	registry := getRegistry(t, client, server)
+
+	if _, err := Bind[Object](registry, 7, 1); err == nil || !strings.Contains(err.Error(), "not a known interface") {
+		t.Fatalf("Bind returned %v, expected an error about the unknown interface", err)
+	}
+}
+
+func TestServerObjectInheritsVersion(t *testing.T) {
+	client, server := newTestClient(t)
+	registry := getRegistry(t, client, server)
+
+	manager, err := Bind[WlDataDeviceManager](registry, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seat, err := Bind[WlSeat](registry, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	device, err := manager.GetDataDevice(seat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The offer is created by the compositor, and inherits the version of the data device
+	offers := make(chan WlDataOffer, 1)
+	device.OnDataOffer(func(offer WlDataOffer) {
+		offers <- offer
+	})
+	go client.Listen()
+
+	server.Write(wayland.NewMessage(device.id, 0, uint32(wayland.ServerIdStart)))
+	server.Flush()
+
+	select {
+	case offer := <-offers:
+		if offer.id != wayland.ServerIdStart || offer.iface != "wl_data_offer" || offer.version != 2 {
+			t.Fatalf("got %s#%d of version %d, expected wl_data_offer#%d of version 2", offer.iface, offer.id, offer.version, wayland.ServerIdStart)
+		}
+		if object, ok := client.Object(offer.id); !ok || object != Object(offer) {
+			t.Fatal("offer is missing from the object table")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("data_offer event was not delivered")
+	}
+}
+
+func TestNullableArguments(t *testing.T) {
+	client, server := newTestClient(t)
+	registry := getRegistry(t, client, server)
+
+	compositor, _ := Bind[WlCompositor](registry, 1, 1)
+	manager, _ := Bind[WlDataDeviceManager](registry, 2, 3)
+	seat, _ := Bind[WlSeat](registry, 3, 1)
+	surface, _ := compositor.CreateSurface()
+	source, _ := manager.CreateDataSource()
+	device, err := manager.GetDataDevice(seat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	for range 6 {
+		if _, err := server.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Requests encode nil objects as ID 0 and nil strings with a length of 0
+	mimeType := "text/plain"
+	device.StartDrag(nil, surface, nil, 5)
+	device.StartDrag(&source, surface, &surface, 6)
+	offer := WlDataOffer(client.NewObject("wl_data_offer", 3))
+	offer.Accept(7, nil)
+	offer.Accept(8, &mimeType)
+	client.Flush()
+
+	for _, expected := range [][2]uint32{{0, 0}, {source.id, surface.id}} {
+		msg := readRequest(t, server, device.id, 0)
+		sourceId, _, iconId := msg.ReadUint32(), msg.ReadUint32(), msg.ReadUint32()
+		if sourceId != expected[0] || iconId != expected[1] {
+			t.Errorf("sent source %d and icon %d, expected %d and %d", sourceId, iconId, expected[0], expected[1])
+		}
+	}
+	for _, expected := range []*string{nil, &mimeType} {
+		msg := readRequest(t, server, offer.id, 0)
+		msg.ReadUint32()
+		if value := msg.ReadNullableString(); (value == nil) != (expected == nil) || (value != nil && *value != *expected) {
+			t.Errorf("sent mime type %v, expected %v", value, expected)
+		}
+	}
+
+	// Events decode ID 0 and strings with a length of 0 as nil
+	selections := make(chan *WlDataOffer, 1)
+	device.OnSelection(func(offer *WlDataOffer) {
+		selections <- offer
+	})
+	targets := make(chan *string, 2)
+	source.OnTarget(func(mimeType *string) {
+		targets <- mimeType
+	})
+	go client.Listen()
+
+	server.Write(wayland.NewMessage(device.id, 5, uint32(0)))
+	server.Write(wayland.NewMessage(source.id, 0, (*string)(nil)))
+	server.Write(wayland.NewMessage(source.id, 0, &mimeType))
+	server.Flush()
+
+	timeout := time.After(5 * time.Second)
+	select {
+	case offer := <-selections:
+		if offer != nil {
+			t.Errorf("received selection %d, expected nil", offer.id)
+		}
+	case <-timeout:
+		t.Fatal("selection event was not delivered")
+	}
+	for _, expected := range []*string{nil, &mimeType} {
+		select {
+		case value := <-targets:
+			if (value == nil) != (expected == nil) || (value != nil && *value != *expected) {
+				t.Errorf("received mime type %v, expected %v", value, expected)
+			}
+		case <-timeout:
+			t.Fatal("target event was not delivered")
+		}
+	}
+}
+
+func TestRequestTooLarge(t *testing.T) {
+	client, _ := newTestClient(t)
+	offer := WlDataOffer(client.NewObject("wl_data_offer", 3))
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	if err := offer.Receive(strings.Repeat("x", 70000), int(w.Fd())); err == nil {
+		t.Fatal("sent a request that exceeds the maximum message size")
+	}
+}
@@ -1,12 +1,23 @@
 package wlclient
 
-import "git.whizanth.com/go/wayland"
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"git.whizanth.com/go/wayland"
+)
 
 type Object struct {
 	client  *Client
 	id      uint32
 	iface   string
 	version uint32
+	// destroyed is shared by all copies of an object, and set once a destructor request or event destroyed it
+	destroyed *atomic.Bool
 }
 
 func (object Object) Id() uint32 {
@@ -17,3619 +28,12552 @@ func (object Object) Interface() string {
 	return object.iface
 }
 
+// Version returns the version of the interface the object was created with, which child objects inherit
+func (object Object) Version() uint32 {
+	return object.version
+}
+
+// checkVersion returns an error if the object's version predates the version a request or event was introduced in.
+// Objects of unknown version, e.g. ones missing from the object table, always pass.
+func (object Object) checkVersion(name string, since uint32) error {
+	if object.version == 0 || object.version >= since {
+		return nil
+	}
+
+	return &wayland.UnsupportedVersionError{
+		Interface: object.iface,
+		Name:      name,
+		Since:     since,
+		Version:   object.version,
+	}
+}
+
+// checkRequest returns an error if a request can't be sent, either because the object has been destroyed or because
+// its version predates the version the request was introduced in
+func (object Object) checkRequest(name string, since uint32) error {
+	if object.destroyed != nil && object.destroyed.Load() {
+		return &wayland.ObjectDestroyedError{
+			Interface: object.iface,
+			ObjectId:  object.id,
+			Name:      name,
+		}
+	}
+	return object.checkVersion(name, since)
+}
+
+// enumEntry is a named value of an enum
+type enumEntry struct {
+	value uint32
+	name  string
+}
+
+// bitfieldString formats the flags set in value as names separated by "|", using the single-bit entries of a
+// bitfield enum and falling back to numbers for unknown flags
+func bitfieldString(value uint32, entries []enumEntry) string {
+	var names []string
+	for _, entry := range entries {
+		if entry.value == 0 {
+			if value == 0 {
+				return entry.name
+			}
+		} else if value&entry.value == entry.value {
+			names = append(names, entry.name)
+			value &^= entry.value
+		}
+	}
+
+	if value != 0 || len(names) == 0 {
+		names = append(names, strconv.FormatUint(uint64(value), 10))
+	}
+	return strings.Join(names, "|")
+}
+
+// proxy is implemented by every generated object type
+type proxy interface {
+	~struct {
+		client    *Client
+		id        uint32
+		iface     string
+		version   uint32
+		destroyed *atomic.Bool
+	}
+	Interface() string
+}
+
+// Bind binds the global with the specified name to a new object of type T, using the highest version supported by
+// both the compositor and these bindings. T has to be a generated interface type, Object can't be bound.
+func Bind[T proxy](registry WlRegistry, name uint32, version uint32) (T, error) {
+	var zero T
+	iface, ok := interfaces[zero.Interface()]
+	if !ok {
+		return zero, errors.New("unable to bind " + strconv.Quote(zero.Interface()) + ", which is not a known interface")
+	}
+	if iface.Version < version {
+		version = iface.Version
+	}
+
+	object, err := registry.Bind(name, iface.Name, version)
+	if err != nil {
+		return zero, err
+	}
+	return T(object), nil
+}
+
+// LookupInterface returns the wire format of the interface with the specified name, including the names of its
+// requests, events and enums. Interfaces of protocol packages are only known once the package has been imported.
+func LookupInterface(name string) (*wayland.Interface, bool) {
+	iface, ok := interfaces[name]
+	return iface, ok
+}
+
+// Options configures a client, see wayland.Options
+type Options wayland.Options
+
+// New creates a new client and tries to connect to the compositor, see wayland.NewClient
 func New() (*Client, error) {
-	client, err := wayland.NewClient()
+	return Options{}.New()
+}
+
+// Dial creates a new client connected to the compositor listening on the Unix socket at path, see wayland.Dial
+func Dial(path string) (*Client, error) {
+	return Options{}.Dial(path)
+}
+
+// NewFromConn creates a new client communicating over an established connection, see wayland.NewClientFromConn
+func NewFromConn(conn *net.UnixConn) *Client {
+	return Options{}.NewFromConn(conn)
+}
+
+// NewFromFd creates a new client communicating over the connected Unix socket with the file descriptor fd, see
+// wayland.NewClientFromFd
+func NewFromFd(fd int) (*Client, error) {
+	return Options{}.NewFromFd(fd)
+}
+
+// New is like the package-level New, creating a client configured by options
+func (options Options) New() (*Client, error) {
+	client, err := wayland.Options(options).NewClient()
+	if err != nil {
+		return nil, err
+	}
+	return newClient(client), nil
+}
+
+// Dial is like the package-level Dial, creating a client configured by options
+func (options Options) Dial(path string) (*Client, error) {
+	client, err := wayland.Options(options).Dial(path)
 	if err != nil {
 		return nil, err
 	}
+	return newClient(client), nil
+}
+
+// NewFromConn is like the package-level NewFromConn, creating a client configured by options
+func (options Options) NewFromConn(conn *net.UnixConn) *Client {
+	return newClient(wayland.Options(options).NewClientFromConn(conn))
+}
+
+// NewFromFd is like the package-level NewFromFd, creating a client configured by options
+func (options Options) NewFromFd(fd int) (*Client, error) {
+	client, err := wayland.Options(options).NewClientFromFd(fd)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(client), nil
+}
 
-	result := &Client{Client: client}
-	result.display.client = result
-	result.display.id = result.NewObjectId()
-	result.display.iface = "wl_display"
-	return result, nil
+// newClient creates the bindings of a client of the wayland package
+func newClient(client *wayland.Client) *Client {
+	result := &Client{
+		Client:  client,
+		objects: make(map[uint32]Object),
+	}
+	result.OnFreeObjectId(result.forget)
+	result.OnDestroyObject(result.markDestroyed)
+	result.OnNewObject(result.newServerObject)
+	result.display = WlDisplay(result.newObject("wl_display", 1))
+	return result
 }
 
 type Client struct {
 	*wayland.Client
-	display WlDisplay
+	display       WlDisplay
+	mu            sync.Mutex
+	objects       map[uint32]Object
+	onUnsupported func(err error)
 }
 
 func (client *Client) GetDisplay() WlDisplay {
 	return client.display
 }
 
+// OnUnsupportedListener calls listener whenever a listener is registered for an event the object's version doesn't
+// support, and which therefore will never be called
+func (client *Client) OnUnsupportedListener(listener func(err error)) {
+	client.mu.Lock()
+	client.onUnsupported = listener
+	client.mu.Unlock()
+}
+
+// unsupported reports err to the listener registered with OnUnsupportedListener, unless it is nil
+func (client *Client) unsupported(err error) {
+	client.mu.Lock()
+	listener := client.onUnsupported
+	client.mu.Unlock()
+
+	if err != nil && listener != nil {
+		listener(err)
+	}
+}
+
+// Object returns the live object with the specified ID
+func (client *Client) Object(id uint32) (Object, bool) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	object, ok := client.objects[id]
+	return object, ok
+}
+
+// newObject allocates a new object ID and registers the object, including the interface needed to decode its events
+func (client *Client) newObject(iface string, version uint32) Object {
+	return client.register(Object{client: client, id: client.NewObjectId(), iface: iface, version: version, destroyed: new(atomic.Bool)})
+}
+
+// newServerObject registers an object the compositor created through a new_id event argument, with the version of
+// the object the event was sent to. This happens before the event is delivered, whether or not it has a listener, so
+// listeners attached to the object from within the event listener will receive all of its events.
+func (client *Client) newServerObject(id uint32, iface string, parentId uint32) {
+	parent, _ := client.Object(parentId)
+	client.register(Object{client: client, id: id, iface: iface, version: parent.version, destroyed: new(atomic.Bool)})
+}
+
+// register adds an object to the object table and records the interface needed to decode its events
+func (client *Client) register(object Object) Object {
+	client.SetInterface(object.id, interfaces[object.iface])
+
+	client.mu.Lock()
+	client.objects[object.id] = object
+	client.mu.Unlock()
+	return object
+}
+
+// resolve returns the object referenced by an event argument, falling back to an object of unknown interface if the
+// ID isn't in the object table
+func (client *Client) resolve(id uint32) Object {
+	if object, ok := client.Object(id); ok {
+		return object
+	}
+	return Object{client: client, id: id}
+}
+
+// nullableId returns the ID of an object argument that allows null, which is 0 for nil
+func nullableId[T proxy](object *T) uint32 {
+	if object == nil {
+		return 0
+	}
+	return Object(*object).id
+}
+
+// resolveNullable returns the object referenced by an event argument that allows null, or nil if the argument is
+// null
+func resolveNullable[T proxy](client *Client, id uint32) *T {
+	if id == 0 {
+		return nil
+	}
+
+	object := T(client.resolve(id))
+	return &object
+}
+
+// writeDestructor sends a destructor request and destroys the object. Client-side IDs are destroyed before sending, as
+// the compositor can confirm the destruction before Write returns, while server-side IDs are freed right away and
+// thus destroyed after sending, so the request can still be traced.
+func (client *Client) writeDestructor(id uint32, msg *wayland.Message) error {
+	if id >= wayland.ServerIdStart {
+		err := client.Write(msg)
+		client.DestroyObject(id)
+		return err
+	}
+
+	client.DestroyObject(id)
+	if err := client.Write(msg); err != nil {
+		// No delete_id confirms a destructor that was never sent, so the ID is freed instead of staying a zombie
+		client.FreeObjectId(id)
+		return err
+	}
+	return nil
+}
+
+// markDestroyed marks an object as destroyed, so further requests on any copy of it fail
+func (client *Client) markDestroyed(id uint32) {
+	client.mu.Lock()
+	object, ok := client.objects[id]
+	client.mu.Unlock()
+
+	if ok && object.destroyed != nil {
+		object.destroyed.Store(true)
+	}
+}
+
+// forget removes an object from the object table after its ID has been freed
+func (client *Client) forget(id uint32) {
+	client.mu.Lock()
+	delete(client.objects, id)
+	client.mu.Unlock()
+}
+
+// RegisterInterface adds the interface of a protocol package to the interfaces known to the client. It is called
+// by the generated bindings of protocol packages when they are initialized.
+func RegisterInterface(iface *wayland.Interface) {
+	interfaces[iface.Name] = iface
+}
+
+// Client returns the client the object belongs to
+func (object Object) Client() *Client {
+	return object.client
+}
+
+// CheckVersion returns an error if the object's version predates the version a request or event was introduced in
+func (object Object) CheckVersion(name string, since uint32) error {
+	return object.checkVersion(name, since)
+}
+
+// CheckRequest returns an error if a request can't be sent, either because the object has been destroyed or because
+// its version predates the version the request was introduced in
+func (object Object) CheckRequest(name string, since uint32) error {
+	return object.checkRequest(name, since)
+}
+
+// Unsupported reports err to the listener registered with OnUnsupportedListener, unless it is nil
+func (client *Client) Unsupported(err error) {
+	client.unsupported(err)
+}
+
+// WriteDestructor sends a destructor request and destroys the object
+func (client *Client) WriteDestructor(id uint32, msg *wayland.Message) error {
+	return client.writeDestructor(id, msg)
+}
+
+// NewObject allocates a new object ID and registers the object, including the interface needed to decode its events
+func (client *Client) NewObject(iface string, version uint32) Object {
+	return client.newObject(iface, version)
+}
+
+// Resolve returns the object referenced by an event argument, falling back to an object of unknown interface if the
+// ID isn't in the object table
+func (client *Client) Resolve(id uint32) Object {
+	return client.resolve(id)
+}
+
+// NullableId returns the ID of an object argument that allows null, which is 0 for nil
+func NullableId[T proxy](object *T) uint32 {
+	return nullableId(object)
+}
+
+// ResolveNullable returns the object referenced by an event argument that allows null, or nil if the argument is
+// null
+func ResolveNullable[T proxy](client *Client, id uint32) *T {
+	return resolveNullable[T](client, id)
+}
+
+// WlDisplay is the wl_display interface: core global object
 type WlDisplay Object
 
-func (object WlDisplay) Sync() WlCallback {
-	callback := WlCallback(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_callback",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WlDisplay) Interface() string {
+	return "wl_display"
+}
+
+// WlDisplayError is the error enum of wl_display: global error values
+type WlDisplayError uint32
+
+const (
+	// server couldn't find object
+	WlDisplayErrorInvalidObject WlDisplayError = 0
+	// method doesn't exist on the specified interface or malformed request
+	WlDisplayErrorInvalidMethod WlDisplayError = 1
+	// server is out of memory
+	WlDisplayErrorNoMemory WlDisplayError = 2
+	// implementation error in compositor
+	WlDisplayErrorImplementation WlDisplayError = 3
+)
+
+func (value WlDisplayError) String() string {
+	switch value {
+	case WlDisplayErrorInvalidObject:
+		return "invalid_object"
+	case WlDisplayErrorInvalidMethod:
+		return "invalid_method"
+	case WlDisplayErrorNoMemory:
+		return "no_memory"
+	case WlDisplayErrorImplementation:
+		return "implementation"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Sync sends the sync request: asynchronous roundtrip
+//
+// Arguments:
+//   - callback: callback object for the sync request
+func (object WlDisplay) Sync() (WlCallback, error) {
+	if err := Object(object).checkRequest("sync", 1); err != nil {
+		return WlCallback{}, err
+	}
+
+	callback := WlCallback(object.client.newObject("wl_callback", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, callback.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, callback.id)); err != nil {
+		object.client.FreeObjectId(callback.id)
+		return WlCallback{}, err
+	}
 
-	return callback
+	return callback, nil
 }
 
-func (object WlDisplay) GetRegistry() WlRegistry {
-	registry := WlRegistry(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_registry",
-	})
+// GetRegistry sends the get_registry request: get global registry object
+//
+// Arguments:
+//   - registry: global registry object
+func (object WlDisplay) GetRegistry() (WlRegistry, error) {
+	if err := Object(object).checkRequest("get_registry", 1); err != nil {
+		return WlRegistry{}, err
+	}
+
+	registry := WlRegistry(object.client.newObject("wl_registry", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, registry.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, registry.id)); err != nil {
+		object.client.FreeObjectId(registry.id)
+		return WlRegistry{}, err
+	}
 
-	return registry
+	return registry, nil
 }
 
+// OnError registers a listener for the error event: fatal error event
+//
+// Arguments:
+//   - objectId: object where the error occurred
+//   - code: error code
+//   - message: error description
 func (object WlDisplay) OnError(listener func(objectId Object, code uint32, message string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}, message.ReadUint32(), message.ReadString())
+		listener(object.client.resolve(message.ReadUint32()), message.ReadUint32(), message.ReadString())
 	})
 }
 
+// OnDeleteId registers a listener for the delete_id event: acknowledge object ID deletion
+//
+// Arguments:
+//   - id: deleted object ID
 func (object WlDisplay) OnDeleteId(listener func(id uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WlRegistry is the wl_registry interface: global registry object
 type WlRegistry Object
 
-func (object WlRegistry) Bind(name uint32, iface string, version uint32) Object {
-	id := Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "Object",
+// Interface returns the name of the interface, which is used by Bind
+func (WlRegistry) Interface() string {
+	return "wl_registry"
+}
+
+// Bind sends the bind request: bind an object to the display
+//
+// Arguments:
+//   - name: unique numeric name of the object
+//   - id: bounded object
+func (object WlRegistry) Bind(name uint32, iface string, version uint32) (Object, error) {
+	if err := Object(object).checkRequest("bind", 1); err != nil {
+		return Object{}, err
 	}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, name, iface, version, id.id))
+	id := object.client.newObject(iface, version)
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, name, iface, version, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return Object{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// OnGlobal registers a listener for the global event: announce global object
+//
+// Arguments:
+//   - name: numeric name of the global object
+//   - iface: interface implemented by the object
+//   - version: interface version
 func (object WlRegistry) OnGlobal(listener func(name uint32, iface string, version uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadString(), message.ReadUint32())
 	})
 }
 
+// OnGlobalRemove registers a listener for the global_remove event: announce removal of global object
+//
+// Arguments:
+//   - name: numeric name of the global object
 func (object WlRegistry) OnGlobalRemove(listener func(name uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WlCallback is the wl_callback interface: callback object
+//
+// Clients can handle the 'done' event to get notified when the related request is done.
+//
+// Note, because wl_callback objects are created from multiple independent factory interfaces, the wl_callback interface
+// is frozen at version 1.
 type WlCallback Object
 
+// Interface returns the name of the interface, which is used by Bind
+func (WlCallback) Interface() string {
+	return "wl_callback"
+}
+
+// OnDone registers a listener for the done event: done event
+//
+// Arguments:
+//   - callbackData: request-specific data for the callback
+//
+// This is a destructor, after which the object can no longer be used.
 func (object WlCallback) OnDone(listener func(callbackData uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WlCompositor is the wl_compositor interface: the compositor singleton
 type WlCompositor Object
 
-func (object WlCompositor) CreateSurface() WlSurface {
-	id := WlSurface(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_surface",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WlCompositor) Interface() string {
+	return "wl_compositor"
+}
+
+// CreateSurface sends the create_surface request: create new surface
+func (object WlCompositor) CreateSurface() (WlSurface, error) {
+	if err := Object(object).checkRequest("create_surface", 1); err != nil {
+		return WlSurface{}, err
+	}
+
+	id := WlSurface(object.client.newObject("wl_surface", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlSurface{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object WlCompositor) CreateRegion() WlRegion {
-	id := WlRegion(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_region",
-	})
+// CreateRegion sends the create_region request: create new region
+func (object WlCompositor) CreateRegion() (WlRegion, error) {
+	if err := Object(object).checkRequest("create_region", 1); err != nil {
+		return WlRegion{}, err
+	}
+
+	id := WlRegion(object.client.newObject("wl_region", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlRegion{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WlShmPool is the wl_shm_pool interface: a shared memory pool
 type WlShmPool Object
 
-func (object WlShmPool) CreateBuffer(offset int32, width int32, height int32, stride int32, format uint32) WlBuffer {
-	id := WlBuffer(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_buffer",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WlShmPool) Interface() string {
+	return "wl_shm_pool"
+}
+
+// CreateBuffer sends the create_buffer request: create a buffer from the pool
+func (object WlShmPool) CreateBuffer(offset int32, width int32, height int32, stride int32, format WlShmFormat) (WlBuffer, error) {
+	if err := Object(object).checkRequest("create_buffer", 1); err != nil {
+		return WlBuffer{}, err
+	}
+
+	id := WlBuffer(object.client.newObject("wl_buffer", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id, offset, width, height, stride, format))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id, offset, width, height, stride, uint32(format))); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlBuffer{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object WlShmPool) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request: destroy the pool
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlShmPool) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
-func (object WlShmPool) Resize(size int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, size))
+// Resize sends the resize request: change the size of the pool mapping
+func (object WlShmPool) Resize(size int32) error {
+	if err := Object(object).checkRequest("resize", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, size))
 }
 
+// WlShm is the wl_shm interface: shared memory support
 type WlShm Object
 
-func (object WlShm) CreatePool(fd int, size int32) WlShmPool {
-	id := WlShmPool(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_shm_pool",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WlShm) Interface() string {
+	return "wl_shm"
+}
+
+// WlShmError is the error enum of wl_shm
+type WlShmError uint32
+
+const (
+	WlShmErrorInvalidFormat WlShmError = 0
+	WlShmErrorInvalidStride WlShmError = 1
+	WlShmErrorInvalidFd WlShmError = 2
+)
+
+func (value WlShmError) String() string {
+	switch value {
+	case WlShmErrorInvalidFormat:
+		return "invalid_format"
+	case WlShmErrorInvalidStride:
+		return "invalid_stride"
+	case WlShmErrorInvalidFd:
+		return "invalid_fd"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlShmFormat is the format enum of wl_shm: pixel formats
+type WlShmFormat uint32
+
+const (
+	// 32-bit ARGB format, [31:0] A:R:G:B 8:8:8:8 little endian
+	WlShmFormatArgb8888 WlShmFormat = 0
+	// 32-bit RGB format, [31:0] x:R:G:B 8:8:8:8 little endian
+	WlShmFormatXrgb8888 WlShmFormat = 1
+	WlShmFormatC8 WlShmFormat = 0x20203843
+	WlShmFormatRgb332 WlShmFormat = 0x38424752
+	WlShmFormatBgr233 WlShmFormat = 0x38524742
+	WlShmFormatXrgb4444 WlShmFormat = 0x32315258
+	WlShmFormatXbgr4444 WlShmFormat = 0x32314258
+	WlShmFormatRgbx4444 WlShmFormat = 0x32315852
+	WlShmFormatBgrx4444 WlShmFormat = 0x32315842
+	WlShmFormatArgb4444 WlShmFormat = 0x32315241
+	WlShmFormatAbgr4444 WlShmFormat = 0x32314241
+	WlShmFormatRgba4444 WlShmFormat = 0x32314152
+	WlShmFormatBgra4444 WlShmFormat = 0x32314142
+	WlShmFormatXrgb1555 WlShmFormat = 0x35315258
+	WlShmFormatXbgr1555 WlShmFormat = 0x35314258
+	WlShmFormatRgbx5551 WlShmFormat = 0x35315852
+	WlShmFormatBgrx5551 WlShmFormat = 0x35315842
+	WlShmFormatArgb1555 WlShmFormat = 0x35315241
+	WlShmFormatAbgr1555 WlShmFormat = 0x35314241
+	WlShmFormatRgba5551 WlShmFormat = 0x35314152
+	WlShmFormatBgra5551 WlShmFormat = 0x35314142
+	WlShmFormatRgb565 WlShmFormat = 0x36314752
+	WlShmFormatBgr565 WlShmFormat = 0x36314742
+	WlShmFormatRgb888 WlShmFormat = 0x34324752
+	WlShmFormatBgr888 WlShmFormat = 0x34324742
+	WlShmFormatXbgr8888 WlShmFormat = 0x34324258
+	WlShmFormatRgbx8888 WlShmFormat = 0x34325852
+	WlShmFormatBgrx8888 WlShmFormat = 0x34325842
+	WlShmFormatAbgr8888 WlShmFormat = 0x34324241
+	WlShmFormatRgba8888 WlShmFormat = 0x34324152
+	WlShmFormatBgra8888 WlShmFormat = 0x34324142
+	WlShmFormatXrgb2101010 WlShmFormat = 0x30335258
+	WlShmFormatXbgr2101010 WlShmFormat = 0x30334258
+	WlShmFormatRgbx1010102 WlShmFormat = 0x30335852
+	WlShmFormatBgrx1010102 WlShmFormat = 0x30335842
+	WlShmFormatArgb2101010 WlShmFormat = 0x30335241
+	WlShmFormatAbgr2101010 WlShmFormat = 0x30334241
+	WlShmFormatRgba1010102 WlShmFormat = 0x30334152
+	WlShmFormatBgra1010102 WlShmFormat = 0x30334142
+	WlShmFormatYuyv WlShmFormat = 0x56595559
+	WlShmFormatYvyu WlShmFormat = 0x55595659
+	WlShmFormatUyvy WlShmFormat = 0x59565955
+	WlShmFormatVyuy WlShmFormat = 0x59555956
+	WlShmFormatAyuv WlShmFormat = 0x56555941
+	WlShmFormatNv12 WlShmFormat = 0x3231564e
+	WlShmFormatNv21 WlShmFormat = 0x3132564e
+	WlShmFormatNv16 WlShmFormat = 0x3631564e
+	WlShmFormatNv61 WlShmFormat = 0x3136564e
+	WlShmFormatYuv410 WlShmFormat = 0x39565559
+	WlShmFormatYvu410 WlShmFormat = 0x39555659
+	WlShmFormatYuv411 WlShmFormat = 0x31315559
+	WlShmFormatYvu411 WlShmFormat = 0x31315659
+	WlShmFormatYuv420 WlShmFormat = 0x32315559
+	WlShmFormatYvu420 WlShmFormat = 0x32315659
+	WlShmFormatYuv422 WlShmFormat = 0x36315559
+	WlShmFormatYvu422 WlShmFormat = 0x36315659
+	WlShmFormatYuv444 WlShmFormat = 0x34325559
+	WlShmFormatYvu444 WlShmFormat = 0x34325659
+)
+
+func (value WlShmFormat) String() string {
+	switch value {
+	case WlShmFormatArgb8888:
+		return "argb8888"
+	case WlShmFormatXrgb8888:
+		return "xrgb8888"
+	case WlShmFormatC8:
+		return "c8"
+	case WlShmFormatRgb332:
+		return "rgb332"
+	case WlShmFormatBgr233:
+		return "bgr233"
+	case WlShmFormatXrgb4444:
+		return "xrgb4444"
+	case WlShmFormatXbgr4444:
+		return "xbgr4444"
+	case WlShmFormatRgbx4444:
+		return "rgbx4444"
+	case WlShmFormatBgrx4444:
+		return "bgrx4444"
+	case WlShmFormatArgb4444:
+		return "argb4444"
+	case WlShmFormatAbgr4444:
+		return "abgr4444"
+	case WlShmFormatRgba4444:
+		return "rgba4444"
+	case WlShmFormatBgra4444:
+		return "bgra4444"
+	case WlShmFormatXrgb1555:
+		return "xrgb1555"
+	case WlShmFormatXbgr1555:
+		return "xbgr1555"
+	case WlShmFormatRgbx5551:
+		return "rgbx5551"
+	case WlShmFormatBgrx5551:
+		return "bgrx5551"
+	case WlShmFormatArgb1555:
+		return "argb1555"
+	case WlShmFormatAbgr1555:
+		return "abgr1555"
+	case WlShmFormatRgba5551:
+		return "rgba5551"
+	case WlShmFormatBgra5551:
+		return "bgra5551"
+	case WlShmFormatRgb565:
+		return "rgb565"
+	case WlShmFormatBgr565:
+		return "bgr565"
+	case WlShmFormatRgb888:
+		return "rgb888"
+	case WlShmFormatBgr888:
+		return "bgr888"
+	case WlShmFormatXbgr8888:
+		return "xbgr8888"
+	case WlShmFormatRgbx8888:
+		return "rgbx8888"
+	case WlShmFormatBgrx8888:
+		return "bgrx8888"
+	case WlShmFormatAbgr8888:
+		return "abgr8888"
+	case WlShmFormatRgba8888:
+		return "rgba8888"
+	case WlShmFormatBgra8888:
+		return "bgra8888"
+	case WlShmFormatXrgb2101010:
+		return "xrgb2101010"
+	case WlShmFormatXbgr2101010:
+		return "xbgr2101010"
+	case WlShmFormatRgbx1010102:
+		return "rgbx1010102"
+	case WlShmFormatBgrx1010102:
+		return "bgrx1010102"
+	case WlShmFormatArgb2101010:
+		return "argb2101010"
+	case WlShmFormatAbgr2101010:
+		return "abgr2101010"
+	case WlShmFormatRgba1010102:
+		return "rgba1010102"
+	case WlShmFormatBgra1010102:
+		return "bgra1010102"
+	case WlShmFormatYuyv:
+		return "yuyv"
+	case WlShmFormatYvyu:
+		return "yvyu"
+	case WlShmFormatUyvy:
+		return "uyvy"
+	case WlShmFormatVyuy:
+		return "vyuy"
+	case WlShmFormatAyuv:
+		return "ayuv"
+	case WlShmFormatNv12:
+		return "nv12"
+	case WlShmFormatNv21:
+		return "nv21"
+	case WlShmFormatNv16:
+		return "nv16"
+	case WlShmFormatNv61:
+		return "nv61"
+	case WlShmFormatYuv410:
+		return "yuv410"
+	case WlShmFormatYvu410:
+		return "yvu410"
+	case WlShmFormatYuv411:
+		return "yuv411"
+	case WlShmFormatYvu411:
+		return "yvu411"
+	case WlShmFormatYuv420:
+		return "yuv420"
+	case WlShmFormatYvu420:
+		return "yvu420"
+	case WlShmFormatYuv422:
+		return "yuv422"
+	case WlShmFormatYvu422:
+		return "yvu422"
+	case WlShmFormatYuv444:
+		return "yuv444"
+	case WlShmFormatYvu444:
+		return "yvu444"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// CreatePool sends the create_pool request: create a shm pool
+func (object WlShm) CreatePool(fd int, size int32) (WlShmPool, error) {
+	if err := Object(object).checkRequest("create_pool", 1); err != nil {
+		return WlShmPool{}, err
+	}
+
+	id := WlShmPool(object.client.newObject("wl_shm_pool", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id, size).WithFds(fd))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id, size).WithFds(fd)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlShmPool{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object WlShm) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Release sends the release request: release the shm object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 2 of wl_shm.
+func (object WlShm) Release() error {
+	if err := Object(object).checkRequest("release", 2); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
-func (object WlShm) OnFormat(listener func(format uint32)) chan struct{} {
+// OnFormat registers a listener for the format event: pixel format description
+func (object WlShm) OnFormat(listener func(format WlShmFormat)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlShmFormat(message.ReadUint32()))
 	})
 }
 
+// WlBuffer is the wl_buffer interface: content for a wl_surface
 type WlBuffer Object
 
-func (object WlBuffer) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlBuffer) Interface() string {
+	return "wl_buffer"
+}
+
+// Destroy sends the destroy request: destroy a buffer
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlBuffer) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnRelease registers a listener for the release event: compositor releases buffer
 func (object WlBuffer) OnRelease(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WlDataOffer is the wl_data_offer interface: offer to transfer data
 type WlDataOffer Object
 
-func (object WlDataOffer) Accept(serial uint32, mimeType string) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serial, mimeType))
+// Interface returns the name of the interface, which is used by Bind
+func (WlDataOffer) Interface() string {
+	return "wl_data_offer"
+}
+
+// WlDataOfferError is the error enum of wl_data_offer
+type WlDataOfferError uint32
+
+const (
+	WlDataOfferErrorInvalidFinish WlDataOfferError = 0
+	WlDataOfferErrorInvalidActionMask WlDataOfferError = 1
+	WlDataOfferErrorInvalidAction WlDataOfferError = 2
+	WlDataOfferErrorInvalidOffer WlDataOfferError = 3
+)
+
+func (value WlDataOfferError) String() string {
+	switch value {
+	case WlDataOfferErrorInvalidFinish:
+		return "invalid_finish"
+	case WlDataOfferErrorInvalidActionMask:
+		return "invalid_action_mask"
+	case WlDataOfferErrorInvalidAction:
+		return "invalid_action"
+	case WlDataOfferErrorInvalidOffer:
+		return "invalid_offer"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Accept sends the accept request: accept one of the offered mime types
+//
+// Arguments:
+//   - mimeType: nil for null
+func (object WlDataOffer) Accept(serial uint32, mimeType *string) error {
+	if err := Object(object).checkRequest("accept", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, serial, mimeType))
 }
 
-func (object WlDataOffer) Receive(mimeType string, fd int) {
-	object.client.Write(wayland.NewMessage(object.id, 1, mimeType).WithFds(fd))
+// Receive sends the receive request: request that the data is transferred
+func (object WlDataOffer) Receive(mimeType string, fd int) error {
+	if err := Object(object).checkRequest("receive", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, mimeType).WithFds(fd))
 }
 
-func (object WlDataOffer) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Destroy sends the destroy request: destroy data offer
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlDataOffer) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
-func (object WlDataOffer) Finish() {
-	object.client.Write(wayland.NewMessage(object.id, 3))
+// Finish sends the finish request: the offer will no longer be used
+//
+// Available since version 3 of wl_data_offer.
+func (object WlDataOffer) Finish() error {
+	if err := Object(object).checkRequest("finish", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3))
 }
 
-func (object WlDataOffer) SetActions(dndActions uint32, preferredAction uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, dndActions, preferredAction))
+// SetActions sends the set_actions request: set the available/preferred drag-and-drop actions
+//
+// Available since version 3 of wl_data_offer.
+func (object WlDataOffer) SetActions(dndActions WlDataDeviceManagerDndAction, preferredAction WlDataDeviceManagerDndAction) error {
+	if err := Object(object).checkRequest("set_actions", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, uint32(dndActions), uint32(preferredAction)))
 }
 
+// OnOffer registers a listener for the offer event: advertise offered mime type
 func (object WlDataOffer) OnOffer(listener func(mimeType string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
-func (object WlDataOffer) OnSourceActions(listener func(sourceActions uint32)) chan struct{} {
+// OnSourceActions registers a listener for the source_actions event: notify the source-side available actions
+//
+// Available since version 3 of wl_data_offer.
+func (object WlDataOffer) OnSourceActions(listener func(sourceActions WlDataDeviceManagerDndAction)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("source_actions", 3))
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlDataDeviceManagerDndAction(message.ReadUint32()))
 	})
 }
 
-func (object WlDataOffer) OnAction(listener func(dndAction uint32)) chan struct{} {
+// OnAction registers a listener for the action event: notify the selected action
+//
+// Available since version 3 of wl_data_offer.
+func (object WlDataOffer) OnAction(listener func(dndAction WlDataDeviceManagerDndAction)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("action", 3))
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlDataDeviceManagerDndAction(message.ReadUint32()))
 	})
 }
 
+// WlDataSource is the wl_data_source interface: offer to transfer data
 type WlDataSource Object
 
-func (object WlDataSource) Offer(mimeType string) {
-	object.client.Write(wayland.NewMessage(object.id, 0, mimeType))
+// Interface returns the name of the interface, which is used by Bind
+func (WlDataSource) Interface() string {
+	return "wl_data_source"
+}
+
+// WlDataSourceError is the error enum of wl_data_source
+type WlDataSourceError uint32
+
+const (
+	WlDataSourceErrorInvalidActionMask WlDataSourceError = 0
+	WlDataSourceErrorInvalidSource WlDataSourceError = 1
+)
+
+func (value WlDataSourceError) String() string {
+	switch value {
+	case WlDataSourceErrorInvalidActionMask:
+		return "invalid_action_mask"
+	case WlDataSourceErrorInvalidSource:
+		return "invalid_source"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Offer sends the offer request: add an offered mime type
+func (object WlDataSource) Offer(mimeType string) error {
+	if err := Object(object).checkRequest("offer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, mimeType))
 }
 
-func (object WlDataSource) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request: destroy the data source
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlDataSource) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
-func (object WlDataSource) SetActions(dndActions uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, dndActions))
+// SetActions sends the set_actions request: set the available drag-and-drop actions
+//
+// Available since version 3 of wl_data_source.
+func (object WlDataSource) SetActions(dndActions WlDataDeviceManagerDndAction) error {
+	if err := Object(object).checkRequest("set_actions", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, uint32(dndActions)))
 }
 
-func (object WlDataSource) OnTarget(listener func(mimeType string)) chan struct{} {
+// OnTarget registers a listener for the target event: a target accepts an offered mime type
+//
+// Arguments:
+//   - mimeType: nil for null
+func (object WlDataSource) OnTarget(listener func(mimeType *string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadString())
+		listener(message.ReadNullableString())
 	})
 }
 
+// OnSend registers a listener for the send event: send the data
 func (object WlDataSource) OnSend(listener func(mimeType string, fd int)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadString(), message.ReadFd())
 	})
 }
 
+// OnCancelled registers a listener for the cancelled event: selection was cancelled
 func (object WlDataSource) OnCancelled(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnDndDropPerformed registers a listener for the dnd_drop_performed event: the drag-and-drop operation physically
+// finished
+//
+// Available since version 3 of wl_data_source.
 func (object WlDataSource) OnDndDropPerformed(listener func()) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("dnd_drop_performed", 3))
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnDndFinished registers a listener for the dnd_finished event: the drag-and-drop operation concluded
+//
+// Available since version 3 of wl_data_source.
 func (object WlDataSource) OnDndFinished(listener func()) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("dnd_finished", 3))
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
-func (object WlDataSource) OnAction(listener func(dndAction uint32)) chan struct{} {
+// OnAction registers a listener for the action event: notify the selected action
+//
+// Available since version 3 of wl_data_source.
+func (object WlDataSource) OnAction(listener func(dndAction WlDataDeviceManagerDndAction)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("action", 3))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlDataDeviceManagerDndAction(message.ReadUint32()))
 	})
 }
 
+// WlDataDevice is the wl_data_device interface: data transfer device
 type WlDataDevice Object
 
-func (object WlDataDevice) StartDrag(source WlDataSource, origin WlSurface, icon WlSurface, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, source.id, origin.id, icon.id, serial))
+// Interface returns the name of the interface, which is used by Bind
+func (WlDataDevice) Interface() string {
+	return "wl_data_device"
 }
 
-func (object WlDataDevice) SetSelection(source WlDataSource, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, source.id, serial))
+// WlDataDeviceError is the error enum of wl_data_device
+type WlDataDeviceError uint32
+
+const (
+	WlDataDeviceErrorRole WlDataDeviceError = 0
+	WlDataDeviceErrorUsedSource WlDataDeviceError = 1
+)
+
+func (value WlDataDeviceError) String() string {
+	switch value {
+	case WlDataDeviceErrorRole:
+		return "role"
+	case WlDataDeviceErrorUsedSource:
+		return "used_source"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// StartDrag sends the start_drag request: start drag-and-drop operation
+//
+// Arguments:
+//   - source: nil for null
+//   - icon: nil for null
+func (object WlDataDevice) StartDrag(source *WlDataSource, origin WlSurface, icon *WlSurface, serial uint32) error {
+	if err := Object(object).checkRequest("start_drag", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, nullableId(source), origin.id, nullableId(icon), serial))
+}
+
+// SetSelection sends the set_selection request: copy data to the selection
+//
+// Arguments:
+//   - source: nil for null
+func (object WlDataDevice) SetSelection(source *WlDataSource, serial uint32) error {
+	if err := Object(object).checkRequest("set_selection", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, nullableId(source), serial))
 }
 
-func (object WlDataDevice) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Release sends the release request: destroy data device
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 2 of wl_data_device.
+func (object WlDataDevice) Release() error {
+	if err := Object(object).checkRequest("release", 2); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
+// OnDataOffer registers a listener for the data_offer event: introduce a new wl_data_offer
 func (object WlDataDevice) OnDataOffer(listener func(id WlDataOffer)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(WlDataOffer(Object{client: object.client, id: object.client.NewObjectId(), iface: "wl_data_offer"}))
+		listener(WlDataOffer(object.client.resolve(message.ReadUint32())))
 	})
 }
 
-func (object WlDataDevice) OnEnter(listener func(serial uint32, surface WlSurface, x wayland.Fixed, y wayland.Fixed, id WlDataOffer)) chan struct{} {
+// OnEnter registers a listener for the enter event: initiate drag-and-drop session
+//
+// Arguments:
+//   - id: nil for null
+func (object WlDataDevice) OnEnter(listener func(serial uint32, surface WlSurface, x wayland.Fixed, y wayland.Fixed, id *WlDataOffer)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), message.ReadFixed(), message.ReadFixed(), WlDataOffer(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadFixed(), message.ReadFixed(), resolveNullable[WlDataOffer](object.client, message.ReadUint32()))
 	})
 }
 
+// OnLeave registers a listener for the leave event: end drag-and-drop session
 func (object WlDataDevice) OnLeave(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnMotion registers a listener for the motion event: drag-and-drop session motion
 func (object WlDataDevice) OnMotion(listener func(time uint32, x wayland.Fixed, y wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnDrop registers a listener for the drop event: end drag-and-drop session successfully
 func (object WlDataDevice) OnDrop(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
-func (object WlDataDevice) OnSelection(listener func(id WlDataOffer)) chan struct{} {
+// OnSelection registers a listener for the selection event: advertise new selection
+//
+// Arguments:
+//   - id: nil for null
+func (object WlDataDevice) OnSelection(listener func(id *WlDataOffer)) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
-		listener(WlDataOffer(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(resolveNullable[WlDataOffer](object.client, message.ReadUint32()))
 	})
 }
 
+// WlDataDeviceManager is the wl_data_device_manager interface: data transfer interface
 type WlDataDeviceManager Object
 
-func (object WlDataDeviceManager) CreateDataSource() WlDataSource {
-	id := WlDataSource(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_data_source",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WlDataDeviceManager) Interface() string {
+	return "wl_data_device_manager"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id))
+// WlDataDeviceManagerDndAction is the dnd_action enum of wl_data_device_manager
+type WlDataDeviceManagerDndAction uint32
 
-	return id
-}
+const (
+	WlDataDeviceManagerDndActionNone WlDataDeviceManagerDndAction = 0
+	WlDataDeviceManagerDndActionCopy WlDataDeviceManagerDndAction = 1
+	WlDataDeviceManagerDndActionMove WlDataDeviceManagerDndAction = 2
+	WlDataDeviceManagerDndActionAsk WlDataDeviceManagerDndAction = 4
+)
 
-func (object WlDataDeviceManager) GetDataDevice(seat WlSeat) WlDataDevice {
-	id := WlDataDevice(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_data_device",
+func (value WlDataDeviceManagerDndAction) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0, "none"},
+		{1, "copy"},
+		{2, "move"},
+		{4, "ask"},
 	})
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id))
-
-	return id
+// Has reports whether all flags set in flag are also set in value
+func (value WlDataDeviceManagerDndAction) Has(flag WlDataDeviceManagerDndAction) bool {
+	return value&flag == flag
 }
 
-type WlShell Object
+// CreateDataSource sends the create_data_source request: create a new data source
+func (object WlDataDeviceManager) CreateDataSource() (WlDataSource, error) {
+	if err := Object(object).checkRequest("create_data_source", 1); err != nil {
+		return WlDataSource{}, err
+	}
 
-func (object WlShell) GetShellSurface(surface WlSurface) WlShellSurface {
-	id := WlShellSurface(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_shell_surface",
-	})
+	id := WlDataSource(object.client.newObject("wl_data_source", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlDataSource{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-type WlShellSurface Object
+// GetDataDevice sends the get_data_device request: create a new data device
+func (object WlDataDeviceManager) GetDataDevice(seat WlSeat) (WlDataDevice, error) {
+	if err := Object(object).checkRequest("get_data_device", 1); err != nil {
+		return WlDataDevice{}, err
+	}
 
-func (object WlShellSurface) Pong(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serial))
-}
+	id := WlDataDevice(object.client.newObject("wl_data_device", object.version))
 
-func (object WlShellSurface) Move(seat WlSeat, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, seat.id, serial))
-}
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlDataDevice{}, err
+	}
 
-func (object WlShellSurface) Resize(seat WlSeat, serial uint32, edges uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, seat.id, serial, edges))
+	return id, nil
 }
 
-func (object WlShellSurface) SetToplevel() {
-	object.client.Write(wayland.NewMessage(object.id, 3))
-}
+// WlShell is the wl_shell interface: create desktop-style surfaces
+type WlShell Object
 
-func (object WlShellSurface) SetTransient(parent WlSurface, x int32, y int32, flags uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, parent.id, x, y, flags))
+// Interface returns the name of the interface, which is used by Bind
+func (WlShell) Interface() string {
+	return "wl_shell"
 }
 
-func (object WlShellSurface) SetFullscreen(method uint32, framerate uint32, output WlOutput) {
-	object.client.Write(wayland.NewMessage(object.id, 5, method, framerate, output.id))
-}
+// WlShellError is the error enum of wl_shell
+type WlShellError uint32
 
-func (object WlShellSurface) SetPopup(seat WlSeat, serial uint32, parent WlSurface, x int32, y int32, flags uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 6, seat.id, serial, parent.id, x, y, flags))
-}
+const (
+	WlShellErrorRole WlShellError = 0
+)
 
-func (object WlShellSurface) SetMaximized(output WlOutput) {
-	object.client.Write(wayland.NewMessage(object.id, 7, output.id))
+func (value WlShellError) String() string {
+	switch value {
+	case WlShellErrorRole:
+		return "role"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object WlShellSurface) SetTitle(title string) {
-	object.client.Write(wayland.NewMessage(object.id, 8, title))
-}
+// GetShellSurface sends the get_shell_surface request: create a shell surface from a surface
+func (object WlShell) GetShellSurface(surface WlSurface) (WlShellSurface, error) {
+	if err := Object(object).checkRequest("get_shell_surface", 1); err != nil {
+		return WlShellSurface{}, err
+	}
+
+	id := WlShellSurface(object.client.newObject("wl_shell_surface", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlShellSurface{}, err
+	}
 
-func (object WlShellSurface) SetClass(class string) {
-	object.client.Write(wayland.NewMessage(object.id, 9, class))
+	return id, nil
 }
 
-func (object WlShellSurface) OnPing(listener func(serial uint32)) chan struct{} {
-	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32())
-	})
+// WlShellSurface is the wl_shell_surface interface: desktop-style metadata interface
+type WlShellSurface Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WlShellSurface) Interface() string {
+	return "wl_shell_surface"
 }
 
-func (object WlShellSurface) OnConfigure(listener func(edges uint32, width int32, height int32)) chan struct{} {
-	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadInt32(), message.ReadInt32())
+// WlShellSurfaceResize is the resize enum of wl_shell_surface
+type WlShellSurfaceResize uint32
+
+const (
+	WlShellSurfaceResizeNone WlShellSurfaceResize = 0
+	WlShellSurfaceResizeTop WlShellSurfaceResize = 1
+	WlShellSurfaceResizeBottom WlShellSurfaceResize = 2
+	WlShellSurfaceResizeLeft WlShellSurfaceResize = 4
+	WlShellSurfaceResizeTopLeft WlShellSurfaceResize = 5
+	WlShellSurfaceResizeBottomLeft WlShellSurfaceResize = 6
+	WlShellSurfaceResizeRight WlShellSurfaceResize = 8
+	WlShellSurfaceResizeTopRight WlShellSurfaceResize = 9
+	WlShellSurfaceResizeBottomRight WlShellSurfaceResize = 10
+)
+
+func (value WlShellSurfaceResize) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0, "none"},
+		{1, "top"},
+		{2, "bottom"},
+		{4, "left"},
+		{8, "right"},
 	})
 }
 
-func (object WlShellSurface) OnPopupDone(listener func()) chan struct{} {
-	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener()
-	})
+// Has reports whether all flags set in flag are also set in value
+func (value WlShellSurfaceResize) Has(flag WlShellSurfaceResize) bool {
+	return value&flag == flag
 }
 
-type WlSurface Object
+// WlShellSurfaceTransient is the transient enum of wl_shell_surface
+type WlShellSurfaceTransient uint32
 
-func (object WlSurface) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
-}
+const (
+	WlShellSurfaceTransientInactive WlShellSurfaceTransient = 0x1
+)
 
-func (object WlSurface) Attach(buffer WlBuffer, x int32, y int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, buffer.id, x, y))
+func (value WlShellSurfaceTransient) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0x1, "inactive"},
+	})
 }
 
-func (object WlSurface) Damage(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
+// Has reports whether all flags set in flag are also set in value
+func (value WlShellSurfaceTransient) Has(flag WlShellSurfaceTransient) bool {
+	return value&flag == flag
 }
 
-func (object WlSurface) Frame() WlCallback {
-	callback := WlCallback(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_callback",
-	})
+// WlShellSurfaceFullscreenMethod is the fullscreen_method enum of wl_shell_surface
+type WlShellSurfaceFullscreenMethod uint32
 
-	object.client.Write(wayland.NewMessage(object.id, 3, callback.id))
+const (
+	WlShellSurfaceFullscreenMethodDefault WlShellSurfaceFullscreenMethod = 0
+	WlShellSurfaceFullscreenMethodScale WlShellSurfaceFullscreenMethod = 1
+	WlShellSurfaceFullscreenMethodDriver WlShellSurfaceFullscreenMethod = 2
+	WlShellSurfaceFullscreenMethodFill WlShellSurfaceFullscreenMethod = 3
+)
 
-	return callback
+func (value WlShellSurfaceFullscreenMethod) String() string {
+	switch value {
+	case WlShellSurfaceFullscreenMethodDefault:
+		return "default"
+	case WlShellSurfaceFullscreenMethodScale:
+		return "scale"
+	case WlShellSurfaceFullscreenMethodDriver:
+		return "driver"
+	case WlShellSurfaceFullscreenMethodFill:
+		return "fill"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object WlSurface) SetOpaqueRegion(region WlRegion) {
-	object.client.Write(wayland.NewMessage(object.id, 4, region.id))
-}
+// Pong sends the pong request: respond to a ping event
+func (object WlShellSurface) Pong(serial uint32) error {
+	if err := Object(object).checkRequest("pong", 1); err != nil {
+		return err
+	}
 
-func (object WlSurface) SetInputRegion(region WlRegion) {
-	object.client.Write(wayland.NewMessage(object.id, 5, region.id))
+	return object.client.Write(wayland.NewMessage(object.id, 0, serial))
 }
 
-func (object WlSurface) Commit() {
-	object.client.Write(wayland.NewMessage(object.id, 6))
-}
+// Move sends the move request: start an interactive move
+func (object WlShellSurface) Move(seat WlSeat, serial uint32) error {
+	if err := Object(object).checkRequest("move", 1); err != nil {
+		return err
+	}
 
-func (object WlSurface) SetBufferTransform(transform int32) {
-	object.client.Write(wayland.NewMessage(object.id, 7, transform))
+	return object.client.Write(wayland.NewMessage(object.id, 1, seat.id, serial))
 }
 
-func (object WlSurface) SetBufferScale(scale int32) {
-	object.client.Write(wayland.NewMessage(object.id, 8, scale))
-}
+// Resize sends the resize request: start an interactive resize
+func (object WlShellSurface) Resize(seat WlSeat, serial uint32, edges WlShellSurfaceResize) error {
+	if err := Object(object).checkRequest("resize", 1); err != nil {
+		return err
+	}
 
-func (object WlSurface) DamageBuffer(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 9, x, y, width, height))
+	return object.client.Write(wayland.NewMessage(object.id, 2, seat.id, serial, uint32(edges)))
 }
 
-func (object WlSurface) Offset(x int32, y int32) {
-	object.client.Write(wayland.NewMessage(object.id, 10, x, y))
+// SetToplevel sends the set_toplevel request: make the surface a toplevel surface
+func (object WlShellSurface) SetToplevel() error {
+	if err := Object(object).checkRequest("set_toplevel", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3))
 }
 
-func (object WlSurface) OnEnter(listener func(output WlOutput)) chan struct{} {
+// SetTransient sends the set_transient request: make the surface a transient surface
+func (object WlShellSurface) SetTransient(parent WlSurface, x int32, y int32, flags WlShellSurfaceTransient) error {
+	if err := Object(object).checkRequest("set_transient", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, parent.id, x, y, uint32(flags)))
+}
+
+// SetFullscreen sends the set_fullscreen request: make the surface a fullscreen surface
+//
+// Arguments:
+//   - output: nil for null
+func (object WlShellSurface) SetFullscreen(method WlShellSurfaceFullscreenMethod, framerate uint32, output *WlOutput) error {
+	if err := Object(object).checkRequest("set_fullscreen", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, uint32(method), framerate, nullableId(output)))
+}
+
+// SetPopup sends the set_popup request: make the surface a popup surface
+func (object WlShellSurface) SetPopup(seat WlSeat, serial uint32, parent WlSurface, x int32, y int32, flags WlShellSurfaceTransient) error {
+	if err := Object(object).checkRequest("set_popup", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6, seat.id, serial, parent.id, x, y, uint32(flags)))
+}
+
+// SetMaximized sends the set_maximized request: make the surface a maximized surface
+//
+// Arguments:
+//   - output: nil for null
+func (object WlShellSurface) SetMaximized(output *WlOutput) error {
+	if err := Object(object).checkRequest("set_maximized", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7, nullableId(output)))
+}
+
+// SetTitle sends the set_title request: set surface title
+func (object WlShellSurface) SetTitle(title string) error {
+	if err := Object(object).checkRequest("set_title", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 8, title))
+}
+
+// SetClass sends the set_class request: set surface class
+func (object WlShellSurface) SetClass(class string) error {
+	if err := Object(object).checkRequest("set_class", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 9, class))
+}
+
+// OnPing registers a listener for the ping event: ping client
+func (object WlShellSurface) OnPing(listener func(serial uint32)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadUint32())
+	})
+}
+
+// OnConfigure registers a listener for the configure event: suggest resize
+func (object WlShellSurface) OnConfigure(listener func(edges WlShellSurfaceResize, width int32, height int32)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(WlShellSurfaceResize(message.ReadUint32()), message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnPopupDone registers a listener for the popup_done event: popup interaction is done
+func (object WlShellSurface) OnPopupDone(listener func()) chan struct{} {
+	return object.client.On(object.id, 2, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// WlSurface is the wl_surface interface: an onscreen surface
+type WlSurface Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WlSurface) Interface() string {
+	return "wl_surface"
+}
+
+// WlSurfaceError is the error enum of wl_surface
+type WlSurfaceError uint32
+
+const (
+	WlSurfaceErrorInvalidScale WlSurfaceError = 0
+	WlSurfaceErrorInvalidTransform WlSurfaceError = 1
+	WlSurfaceErrorInvalidSize WlSurfaceError = 2
+	WlSurfaceErrorInvalidOffset WlSurfaceError = 3
+	WlSurfaceErrorDefunctRoleObject WlSurfaceError = 4
+)
+
+func (value WlSurfaceError) String() string {
+	switch value {
+	case WlSurfaceErrorInvalidScale:
+		return "invalid_scale"
+	case WlSurfaceErrorInvalidTransform:
+		return "invalid_transform"
+	case WlSurfaceErrorInvalidSize:
+		return "invalid_size"
+	case WlSurfaceErrorInvalidOffset:
+		return "invalid_offset"
+	case WlSurfaceErrorDefunctRoleObject:
+		return "defunct_role_object"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: delete surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlSurface) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Attach sends the attach request: set the surface contents
+//
+// Arguments:
+//   - buffer: buffer of surface contents, nil for null
+//   - x: surface-local x coordinate
+//   - y: surface-local y coordinate
+func (object WlSurface) Attach(buffer *WlBuffer, x int32, y int32) error {
+	if err := Object(object).checkRequest("attach", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, nullableId(buffer), x, y))
+}
+
+// Damage sends the damage request: mark part of the surface damaged
+func (object WlSurface) Damage(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("damage", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
+}
+
+// Frame sends the frame request: request a frame throttling hint
+//
+// Arguments:
+//   - callback: callback object for the frame request
+func (object WlSurface) Frame() (WlCallback, error) {
+	if err := Object(object).checkRequest("frame", 1); err != nil {
+		return WlCallback{}, err
+	}
+
+	callback := WlCallback(object.client.newObject("wl_callback", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, callback.id)); err != nil {
+		object.client.FreeObjectId(callback.id)
+		return WlCallback{}, err
+	}
+
+	return callback, nil
+}
+
+// SetOpaqueRegion sends the set_opaque_region request: set opaque region
+//
+// Arguments:
+//   - region: nil for null
+func (object WlSurface) SetOpaqueRegion(region *WlRegion) error {
+	if err := Object(object).checkRequest("set_opaque_region", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, nullableId(region)))
+}
+
+// SetInputRegion sends the set_input_region request: set input region
+//
+// Arguments:
+//   - region: nil for null
+func (object WlSurface) SetInputRegion(region *WlRegion) error {
+	if err := Object(object).checkRequest("set_input_region", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, nullableId(region)))
+}
+
+// Commit sends the commit request: commit pending surface state
+func (object WlSurface) Commit() error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6))
+}
+
+// SetBufferTransform sends the set_buffer_transform request: sets the buffer transformation
+//
+// Available since version 2 of wl_surface.
+func (object WlSurface) SetBufferTransform(transform WlOutputTransform) error {
+	if err := Object(object).checkRequest("set_buffer_transform", 2); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7, int32(transform)))
+}
+
+// SetBufferScale sends the set_buffer_scale request: sets the buffer scaling factor
+//
+// Available since version 3 of wl_surface.
+func (object WlSurface) SetBufferScale(scale int32) error {
+	if err := Object(object).checkRequest("set_buffer_scale", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 8, scale))
+}
+
+// DamageBuffer sends the damage_buffer request: mark part of the surface damaged using buffer coordinates
+//
+// Available since version 4 of wl_surface.
+func (object WlSurface) DamageBuffer(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("damage_buffer", 4); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 9, x, y, width, height))
+}
+
+// Offset sends the offset request: set the surface contents offset
+//
+// Available since version 5 of wl_surface.
+func (object WlSurface) Offset(x int32, y int32) error {
+	if err := Object(object).checkRequest("offset", 5); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 10, x, y))
+}
+
+// OnEnter registers a listener for the enter event: surface enters an output
+func (object WlSurface) OnEnter(listener func(output WlOutput)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(WlOutput(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(WlOutput(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnLeave registers a listener for the leave event: surface leaves an output
 func (object WlSurface) OnLeave(listener func(output WlOutput)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(WlOutput(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(WlOutput(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnPreferredBufferScale registers a listener for the preferred_buffer_scale event: preferred buffer scale for the
+// surface
+//
+// Available since version 6 of wl_surface.
 func (object WlSurface) OnPreferredBufferScale(listener func(factor int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("preferred_buffer_scale", 6))
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadInt32())
 	})
 }
 
-func (object WlSurface) OnPreferredBufferTransform(listener func(transform uint32)) chan struct{} {
+// OnPreferredBufferTransform registers a listener for the preferred_buffer_transform event: preferred buffer transform
+// for the surface
+//
+// Available since version 6 of wl_surface.
+func (object WlSurface) OnPreferredBufferTransform(listener func(transform WlOutputTransform)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("preferred_buffer_transform", 6))
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlOutputTransform(message.ReadUint32()))
 	})
 }
 
+// WlSeat is the wl_seat interface: group of input devices
 type WlSeat Object
 
-func (object WlSeat) GetPointer() WlPointer {
-	id := WlPointer(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_pointer",
+// Interface returns the name of the interface, which is used by Bind
+func (WlSeat) Interface() string {
+	return "wl_seat"
+}
+
+// WlSeatCapability is the capability enum of wl_seat: seat capability bitmask
+type WlSeatCapability uint32
+
+const (
+	// the seat has pointer devices
+	WlSeatCapabilityPointer WlSeatCapability = 1
+	// the seat has one or more keyboards
+	WlSeatCapabilityKeyboard WlSeatCapability = 2
+	// the seat has touch devices
+	WlSeatCapabilityTouch WlSeatCapability = 4
+)
+
+func (value WlSeatCapability) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{1, "pointer"},
+		{2, "keyboard"},
+		{4, "touch"},
 	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value WlSeatCapability) Has(flag WlSeatCapability) bool {
+	return value&flag == flag
+}
+
+// WlSeatError is the error enum of wl_seat
+type WlSeatError uint32
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id))
+const (
+	WlSeatErrorMissingCapability WlSeatError = 0
+)
 
-	return id
+func (value WlSeatError) String() string {
+	switch value {
+	case WlSeatErrorMissingCapability:
+		return "missing_capability"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object WlSeat) GetKeyboard() WlKeyboard {
-	id := WlKeyboard(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_keyboard",
-	})
+// GetPointer sends the get_pointer request: return pointer object
+func (object WlSeat) GetPointer() (WlPointer, error) {
+	if err := Object(object).checkRequest("get_pointer", 1); err != nil {
+		return WlPointer{}, err
+	}
+
+	id := WlPointer(object.client.newObject("wl_pointer", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlPointer{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object WlSeat) GetTouch() WlTouch {
-	id := WlTouch(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_touch",
-	})
+// GetKeyboard sends the get_keyboard request: return keyboard object
+func (object WlSeat) GetKeyboard() (WlKeyboard, error) {
+	if err := Object(object).checkRequest("get_keyboard", 1); err != nil {
+		return WlKeyboard{}, err
+	}
+
+	id := WlKeyboard(object.client.newObject("wl_keyboard", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlKeyboard{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id))
+	return id, nil
+}
+
+// GetTouch sends the get_touch request: return touch object
+func (object WlSeat) GetTouch() (WlTouch, error) {
+	if err := Object(object).checkRequest("get_touch", 1); err != nil {
+		return WlTouch{}, err
+	}
+
+	id := WlTouch(object.client.newObject("wl_touch", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlTouch{}, err
+	}
+
+	return id, nil
 }
 
-func (object WlSeat) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 3))
+// Release sends the release request: release the seat object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 5 of wl_seat.
+func (object WlSeat) Release() error {
+	if err := Object(object).checkRequest("release", 5); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 3))
 }
 
-func (object WlSeat) OnCapabilities(listener func(capabilities uint32)) chan struct{} {
+// OnCapabilities registers a listener for the capabilities event: seat capabilities changed
+func (object WlSeat) OnCapabilities(listener func(capabilities WlSeatCapability)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlSeatCapability(message.ReadUint32()))
 	})
 }
 
+// OnName registers a listener for the name event: unique identifier for this seat
+//
+// Available since version 2 of wl_seat.
 func (object WlSeat) OnName(listener func(name string)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("name", 2))
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// WlPointer is the wl_pointer interface: pointer input device
 type WlPointer Object
 
-func (object WlPointer) SetCursor(serial uint32, surface WlSurface, hotspotX int32, hotspotY int32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serial, surface.id, hotspotX, hotspotY))
+// Interface returns the name of the interface, which is used by Bind
+func (WlPointer) Interface() string {
+	return "wl_pointer"
+}
+
+// WlPointerError is the error enum of wl_pointer
+type WlPointerError uint32
+
+const (
+	WlPointerErrorRole WlPointerError = 0
+)
+
+func (value WlPointerError) String() string {
+	switch value {
+	case WlPointerErrorRole:
+		return "role"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlPointerButtonState is the button_state enum of wl_pointer
+type WlPointerButtonState uint32
+
+const (
+	WlPointerButtonStateReleased WlPointerButtonState = 0
+	WlPointerButtonStatePressed WlPointerButtonState = 1
+)
+
+func (value WlPointerButtonState) String() string {
+	switch value {
+	case WlPointerButtonStateReleased:
+		return "released"
+	case WlPointerButtonStatePressed:
+		return "pressed"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlPointerAxis is the axis enum of wl_pointer
+type WlPointerAxis uint32
+
+const (
+	WlPointerAxisVerticalScroll WlPointerAxis = 0
+	WlPointerAxisHorizontalScroll WlPointerAxis = 1
+)
+
+func (value WlPointerAxis) String() string {
+	switch value {
+	case WlPointerAxisVerticalScroll:
+		return "vertical_scroll"
+	case WlPointerAxisHorizontalScroll:
+		return "horizontal_scroll"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlPointerAxisSource is the axis_source enum of wl_pointer
+type WlPointerAxisSource uint32
+
+const (
+	WlPointerAxisSourceWheel WlPointerAxisSource = 0
+	WlPointerAxisSourceFinger WlPointerAxisSource = 1
+	WlPointerAxisSourceContinuous WlPointerAxisSource = 2
+	// Available since version 6 of wl_pointer.
+	WlPointerAxisSourceWheelTilt WlPointerAxisSource = 3
+)
+
+func (value WlPointerAxisSource) String() string {
+	switch value {
+	case WlPointerAxisSourceWheel:
+		return "wheel"
+	case WlPointerAxisSourceFinger:
+		return "finger"
+	case WlPointerAxisSourceContinuous:
+		return "continuous"
+	case WlPointerAxisSourceWheelTilt:
+		return "wheel_tilt"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of wl_pointer value was introduced in
+func (value WlPointerAxisSource) since() uint32 {
+	switch value {
+	case WlPointerAxisSourceWheelTilt:
+		return 6
+	}
+	return 1
+}
+
+// WlPointerAxisRelativeDirection is the axis_relative_direction enum of wl_pointer
+type WlPointerAxisRelativeDirection uint32
+
+const (
+	WlPointerAxisRelativeDirectionIdentical WlPointerAxisRelativeDirection = 0
+	WlPointerAxisRelativeDirectionInverted WlPointerAxisRelativeDirection = 1
+)
+
+func (value WlPointerAxisRelativeDirection) String() string {
+	switch value {
+	case WlPointerAxisRelativeDirectionIdentical:
+		return "identical"
+	case WlPointerAxisRelativeDirectionInverted:
+		return "inverted"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// SetCursor sends the set_cursor request: set the pointer surface
+//
+// Arguments:
+//   - surface: nil for null
+func (object WlPointer) SetCursor(serial uint32, surface *WlSurface, hotspotX int32, hotspotY int32) error {
+	if err := Object(object).checkRequest("set_cursor", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, serial, nullableId(surface), hotspotX, hotspotY))
 }
 
-func (object WlPointer) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Release sends the release request: release the pointer object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of wl_pointer.
+func (object WlPointer) Release() error {
+	if err := Object(object).checkRequest("release", 3); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnEnter registers a listener for the enter event: enter event
 func (object WlPointer) OnEnter(listener func(serial uint32, surface WlSurface, surfaceX wayland.Fixed, surfaceY wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), message.ReadFixed(), message.ReadFixed())
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnLeave registers a listener for the leave event: leave event
 func (object WlPointer) OnLeave(listener func(serial uint32, surface WlSurface)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnMotion registers a listener for the motion event: pointer motion event
 func (object WlPointer) OnMotion(listener func(time uint32, surfaceX wayland.Fixed, surfaceY wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
-func (object WlPointer) OnButton(listener func(serial uint32, time uint32, button uint32, state uint32)) chan struct{} {
+// OnButton registers a listener for the button event: pointer button event
+func (object WlPointer) OnButton(listener func(serial uint32, time uint32, button uint32, state WlPointerButtonState)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), WlPointerButtonState(message.ReadUint32()))
 	})
 }
 
-func (object WlPointer) OnAxis(listener func(time uint32, axis uint32, value wayland.Fixed)) chan struct{} {
+// OnAxis registers a listener for the axis event: axis event
+func (object WlPointer) OnAxis(listener func(time uint32, axis WlPointerAxis, value wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32(), message.ReadFixed())
+		listener(message.ReadUint32(), WlPointerAxis(message.ReadUint32()), message.ReadFixed())
 	})
 }
 
+// OnFrame registers a listener for the frame event: end of a pointer event sequence
+//
+// Available since version 5 of wl_pointer.
 func (object WlPointer) OnFrame(listener func()) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("frame", 5))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
-func (object WlPointer) OnAxisSource(listener func(axisSource uint32)) chan struct{} {
+// OnAxisSource registers a listener for the axis_source event: axis source event
+//
+// Available since version 5 of wl_pointer.
+func (object WlPointer) OnAxisSource(listener func(axisSource WlPointerAxisSource)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("axis_source", 5))
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(WlPointerAxisSource(message.ReadUint32()))
 	})
 }
 
-func (object WlPointer) OnAxisStop(listener func(time uint32, axis uint32)) chan struct{} {
+// OnAxisStop registers a listener for the axis_stop event: axis stop event
+//
+// Available since version 5 of wl_pointer.
+func (object WlPointer) OnAxisStop(listener func(time uint32, axis WlPointerAxis)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("axis_stop", 5))
 	return object.client.On(object.id, 7, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32())
+		listener(message.ReadUint32(), WlPointerAxis(message.ReadUint32()))
 	})
 }
 
-func (object WlPointer) OnAxisDiscrete(listener func(axis uint32, discrete int32)) chan struct{} {
+// OnAxisDiscrete registers a listener for the axis_discrete event: axis click event
+//
+// Available since version 5 of wl_pointer.
+//
+// Deprecated: wl_pointer.axis_discrete is deprecated since version 8 of wl_pointer.
+func (object WlPointer) OnAxisDiscrete(listener func(axis WlPointerAxis, discrete int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("axis_discrete", 5))
 	return object.client.On(object.id, 8, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadInt32())
+		listener(WlPointerAxis(message.ReadUint32()), message.ReadInt32())
 	})
 }
 
-func (object WlPointer) OnAxisValue120(listener func(axis uint32, value120 int32)) chan struct{} {
+// OnAxisValue120 registers a listener for the axis_value120 event: axis high-resolution scroll event
+//
+// Available since version 8 of wl_pointer.
+func (object WlPointer) OnAxisValue120(listener func(axis WlPointerAxis, value120 int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("axis_value120", 8))
 	return object.client.On(object.id, 9, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadInt32())
+		listener(WlPointerAxis(message.ReadUint32()), message.ReadInt32())
 	})
 }
 
-func (object WlPointer) OnAxisRelativeDirection(listener func(axis uint32, direction uint32)) chan struct{} {
+// OnAxisRelativeDirection registers a listener for the axis_relative_direction event: axis relative physical direction
+// event
+//
+// Available since version 9 of wl_pointer.
+func (object WlPointer) OnAxisRelativeDirection(listener func(axis WlPointerAxis, direction WlPointerAxisRelativeDirection)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("axis_relative_direction", 9))
 	return object.client.On(object.id, 10, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32())
+		listener(WlPointerAxis(message.ReadUint32()), WlPointerAxisRelativeDirection(message.ReadUint32()))
 	})
 }
 
+// WlKeyboard is the wl_keyboard interface: keyboard input device
 type WlKeyboard Object
 
-func (object WlKeyboard) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlKeyboard) Interface() string {
+	return "wl_keyboard"
+}
+
+// WlKeyboardKeymapFormat is the keymap_format enum of wl_keyboard
+type WlKeyboardKeymapFormat uint32
+
+const (
+	WlKeyboardKeymapFormatNoKeymap WlKeyboardKeymapFormat = 0
+	WlKeyboardKeymapFormatXkbV1 WlKeyboardKeymapFormat = 1
+)
+
+func (value WlKeyboardKeymapFormat) String() string {
+	switch value {
+	case WlKeyboardKeymapFormatNoKeymap:
+		return "no_keymap"
+	case WlKeyboardKeymapFormatXkbV1:
+		return "xkb_v1"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlKeyboardKeyState is the key_state enum of wl_keyboard
+type WlKeyboardKeyState uint32
+
+const (
+	WlKeyboardKeyStateReleased WlKeyboardKeyState = 0
+	WlKeyboardKeyStatePressed WlKeyboardKeyState = 1
+	// Available since version 10 of wl_keyboard.
+	WlKeyboardKeyStateRepeated WlKeyboardKeyState = 2
+)
+
+func (value WlKeyboardKeyState) String() string {
+	switch value {
+	case WlKeyboardKeyStateReleased:
+		return "released"
+	case WlKeyboardKeyStatePressed:
+		return "pressed"
+	case WlKeyboardKeyStateRepeated:
+		return "repeated"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of wl_keyboard value was introduced in
+func (value WlKeyboardKeyState) since() uint32 {
+	switch value {
+	case WlKeyboardKeyStateRepeated:
+		return 10
+	}
+	return 1
+}
+
+// Release sends the release request: release the keyboard object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of wl_keyboard.
+func (object WlKeyboard) Release() error {
+	if err := Object(object).checkRequest("release", 3); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WlKeyboard) OnKeymap(listener func(format uint32, fd int, size uint32)) chan struct{} {
+// OnKeymap registers a listener for the keymap event: keyboard mapping
+func (object WlKeyboard) OnKeymap(listener func(format WlKeyboardKeymapFormat, fd int, size uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadFd(), message.ReadUint32())
+		listener(WlKeyboardKeymapFormat(message.ReadUint32()), message.ReadFd(), message.ReadUint32())
 	})
 }
 
-func (object WlKeyboard) OnEnter(listener func(serial uint32, surface WlSurface, keys []uint32)) chan struct{} {
+// OnEnter registers a listener for the enter event: enter event
+func (object WlKeyboard) OnEnter(listener func(serial uint32, surface WlSurface, keys wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), message.ReadArray())
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadArray())
 	})
 }
 
+// OnLeave registers a listener for the leave event: leave event
 func (object WlKeyboard) OnLeave(listener func(serial uint32, surface WlSurface)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())))
 	})
 }
 
-func (object WlKeyboard) OnKey(listener func(serial uint32, time uint32, key uint32, state uint32)) chan struct{} {
+// OnKey registers a listener for the key event: key event
+func (object WlKeyboard) OnKey(listener func(serial uint32, time uint32, key uint32, state WlKeyboardKeyState)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), WlKeyboardKeyState(message.ReadUint32()))
 	})
 }
 
+// OnModifiers registers a listener for the modifiers event: modifier and group state
 func (object WlKeyboard) OnModifiers(listener func(serial uint32, modsDepressed uint32, modsLatched uint32, modsLocked uint32, group uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnRepeatInfo registers a listener for the repeat_info event: repeat rate and delay
+//
+// Available since version 4 of wl_keyboard.
 func (object WlKeyboard) OnRepeatInfo(listener func(rate int32, delay int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("repeat_info", 4))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// WlTouch is the wl_touch interface: touchscreen input device
 type WlTouch Object
 
-func (object WlTouch) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlTouch) Interface() string {
+	return "wl_touch"
+}
+
+// Release sends the release request: release the touch object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of wl_touch.
+func (object WlTouch) Release() error {
+	if err := Object(object).checkRequest("release", 3); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnDown registers a listener for the down event: touch down event and beginning of a touch sequence
 func (object WlTouch) OnDown(listener func(serial uint32, time uint32, surface WlSurface, id int32, x wayland.Fixed, y wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), message.ReadInt32(), message.ReadFixed(), message.ReadFixed())
+		listener(message.ReadUint32(), message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadInt32(), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnUp registers a listener for the up event: end of a touch event sequence
 func (object WlTouch) OnUp(listener func(serial uint32, time uint32, id int32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadInt32())
 	})
 }
 
+// OnMotion registers a listener for the motion event: update of touch point coordinates
 func (object WlTouch) OnMotion(listener func(time uint32, id int32, x wayland.Fixed, y wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadInt32(), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnFrame registers a listener for the frame event: end of touch frame event
 func (object WlTouch) OnFrame(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnCancel registers a listener for the cancel event: touch session cancelled
 func (object WlTouch) OnCancel(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnShape registers a listener for the shape event: update shape of touch point
+//
+// Available since version 6 of wl_touch.
 func (object WlTouch) OnShape(listener func(id int32, major wayland.Fixed, minor wayland.Fixed)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("shape", 6))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnOrientation registers a listener for the orientation event: update orientation of touch point
+//
+// Available since version 6 of wl_touch.
 func (object WlTouch) OnOrientation(listener func(id int32, orientation wayland.Fixed)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("orientation", 6))
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadFixed())
 	})
 }
 
+// WlOutput is the wl_output interface: compositor output region
 type WlOutput Object
 
-func (object WlOutput) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlOutput) Interface() string {
+	return "wl_output"
+}
+
+// WlOutputSubpixel is the subpixel enum of wl_output
+type WlOutputSubpixel uint32
+
+const (
+	WlOutputSubpixelUnknown WlOutputSubpixel = 0
+	WlOutputSubpixelNone WlOutputSubpixel = 1
+	WlOutputSubpixelHorizontalRgb WlOutputSubpixel = 2
+	WlOutputSubpixelHorizontalBgr WlOutputSubpixel = 3
+	WlOutputSubpixelVerticalRgb WlOutputSubpixel = 4
+	WlOutputSubpixelVerticalBgr WlOutputSubpixel = 5
+)
+
+func (value WlOutputSubpixel) String() string {
+	switch value {
+	case WlOutputSubpixelUnknown:
+		return "unknown"
+	case WlOutputSubpixelNone:
+		return "none"
+	case WlOutputSubpixelHorizontalRgb:
+		return "horizontal_rgb"
+	case WlOutputSubpixelHorizontalBgr:
+		return "horizontal_bgr"
+	case WlOutputSubpixelVerticalRgb:
+		return "vertical_rgb"
+	case WlOutputSubpixelVerticalBgr:
+		return "vertical_bgr"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlOutputTransform is the transform enum of wl_output: transformation applied to buffer contents
+type WlOutputTransform uint32
+
+const (
+	WlOutputTransformNormal WlOutputTransform = 0
+	WlOutputTransform90 WlOutputTransform = 1
+	WlOutputTransform180 WlOutputTransform = 2
+	WlOutputTransform270 WlOutputTransform = 3
+	WlOutputTransformFlipped WlOutputTransform = 4
+	WlOutputTransformFlipped90 WlOutputTransform = 5
+	WlOutputTransformFlipped180 WlOutputTransform = 6
+	WlOutputTransformFlipped270 WlOutputTransform = 7
+)
+
+func (value WlOutputTransform) String() string {
+	switch value {
+	case WlOutputTransformNormal:
+		return "normal"
+	case WlOutputTransform90:
+		return "90"
+	case WlOutputTransform180:
+		return "180"
+	case WlOutputTransform270:
+		return "270"
+	case WlOutputTransformFlipped:
+		return "flipped"
+	case WlOutputTransformFlipped90:
+		return "flipped_90"
+	case WlOutputTransformFlipped180:
+		return "flipped_180"
+	case WlOutputTransformFlipped270:
+		return "flipped_270"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// WlOutputMode is the mode enum of wl_output
+type WlOutputMode uint32
+
+const (
+	WlOutputModeCurrent WlOutputMode = 0x1
+	WlOutputModePreferred WlOutputMode = 0x2
+)
+
+func (value WlOutputMode) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0x1, "current"},
+		{0x2, "preferred"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value WlOutputMode) Has(flag WlOutputMode) bool {
+	return value&flag == flag
+}
+
+// Release sends the release request: release the output object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of wl_output.
+func (object WlOutput) Release() error {
+	if err := Object(object).checkRequest("release", 3); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WlOutput) OnGeometry(listener func(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel int32, make string, model string, transform int32)) chan struct{} {
+// OnGeometry registers a listener for the geometry event: properties of the output
+func (object WlOutput) OnGeometry(listener func(x int32, y int32, physicalWidth int32, physicalHeight int32, subpixel WlOutputSubpixel, make string, model string, transform WlOutputTransform)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadString(), message.ReadString(), message.ReadInt32())
+		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), WlOutputSubpixel(message.ReadInt32()), message.ReadString(), message.ReadString(), WlOutputTransform(message.ReadInt32()))
 	})
 }
 
-func (object WlOutput) OnMode(listener func(flags uint32, width int32, height int32, refresh int32)) chan struct{} {
+// OnMode registers a listener for the mode event: advertise available modes for the output
+func (object WlOutput) OnMode(listener func(flags WlOutputMode, width int32, height int32, refresh int32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
+		listener(WlOutputMode(message.ReadUint32()), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnDone registers a listener for the done event: sent all information about output
+//
+// Available since version 2 of wl_output.
 func (object WlOutput) OnDone(listener func()) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("done", 2))
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnScale registers a listener for the scale event: output scaling properties
+//
+// Available since version 2 of wl_output.
 func (object WlOutput) OnScale(listener func(factor int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("scale", 2))
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadInt32())
 	})
 }
 
+// OnName registers a listener for the name event: name of this output
+//
+// Available since version 4 of wl_output.
 func (object WlOutput) OnName(listener func(name string)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("name", 4))
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnDescription registers a listener for the description event: human-readable description of this output
+//
+// Available since version 4 of wl_output.
 func (object WlOutput) OnDescription(listener func(description string)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("description", 4))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// WlRegion is the wl_region interface: region interface
 type WlRegion Object
 
-func (object WlRegion) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlRegion) Interface() string {
+	return "wl_region"
 }
 
-func (object WlRegion) Add(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, x, y, width, height))
+// Destroy sends the destroy request: destroy region
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlRegion) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Add sends the add request: add rectangle to region
+func (object WlRegion) Add(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("add", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, x, y, width, height))
 }
 
-func (object WlRegion) Subtract(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
+// Subtract sends the subtract request: subtract rectangle from region
+func (object WlRegion) Subtract(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("subtract", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
 }
 
+// WlSubcompositor is the wl_subcompositor interface: sub-surface compositing
 type WlSubcompositor Object
 
-func (object WlSubcompositor) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlSubcompositor) Interface() string {
+	return "wl_subcompositor"
 }
 
-func (object WlSubcompositor) GetSubsurface(surface WlSurface, parent WlSurface) WlSubsurface {
-	id := WlSubsurface(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_subsurface",
-	})
+// WlSubcompositorError is the error enum of wl_subcompositor
+type WlSubcompositorError uint32
+
+const (
+	WlSubcompositorErrorBadSurface WlSubcompositorError = 0
+	WlSubcompositorErrorBadParent WlSubcompositorError = 1
+)
+
+func (value WlSubcompositorError) String() string {
+	switch value {
+	case WlSubcompositorErrorBadSurface:
+		return "bad_surface"
+	case WlSubcompositorErrorBadParent:
+		return "bad_parent"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: unbind from the subcompositor interface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlSubcompositor) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetSubsurface sends the get_subsurface request: give a surface the role sub-surface
+func (object WlSubcompositor) GetSubsurface(surface WlSurface, parent WlSurface) (WlSubsurface, error) {
+	if err := Object(object).checkRequest("get_subsurface", 1); err != nil {
+		return WlSubsurface{}, err
+	}
+
+	id := WlSubsurface(object.client.newObject("wl_subsurface", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, parent.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, parent.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlSubsurface{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WlSubsurface is the wl_subsurface interface: sub-surface interface to a wl_surface
 type WlSubsurface Object
 
-func (object WlSubsurface) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlSubsurface) Interface() string {
+	return "wl_subsurface"
+}
+
+// WlSubsurfaceError is the error enum of wl_subsurface
+type WlSubsurfaceError uint32
+
+const (
+	WlSubsurfaceErrorBadSurface WlSubsurfaceError = 0
+)
+
+func (value WlSubsurfaceError) String() string {
+	switch value {
+	case WlSubsurfaceErrorBadSurface:
+		return "bad_surface"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: remove sub-surface interface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlSubsurface) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WlSubsurface) SetPosition(x int32, y int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, x, y))
+// SetPosition sends the set_position request: reposition the sub-surface
+func (object WlSubsurface) SetPosition(x int32, y int32) error {
+	if err := Object(object).checkRequest("set_position", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, x, y))
 }
 
-func (object WlSubsurface) PlaceAbove(sibling WlSurface) {
-	object.client.Write(wayland.NewMessage(object.id, 2, sibling.id))
+// PlaceAbove sends the place_above request: restack the sub-surface
+func (object WlSubsurface) PlaceAbove(sibling WlSurface) error {
+	if err := Object(object).checkRequest("place_above", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, sibling.id))
 }
 
-func (object WlSubsurface) PlaceBelow(sibling WlSurface) {
-	object.client.Write(wayland.NewMessage(object.id, 3, sibling.id))
+// PlaceBelow sends the place_below request: restack the sub-surface
+func (object WlSubsurface) PlaceBelow(sibling WlSurface) error {
+	if err := Object(object).checkRequest("place_below", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, sibling.id))
 }
 
-func (object WlSubsurface) SetSync() {
-	object.client.Write(wayland.NewMessage(object.id, 4))
+// SetSync sends the set_sync request: set sub-surface to synchronized mode
+func (object WlSubsurface) SetSync() error {
+	if err := Object(object).checkRequest("set_sync", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4))
 }
 
-func (object WlSubsurface) SetDesync() {
-	object.client.Write(wayland.NewMessage(object.id, 5))
+// SetDesync sends the set_desync request: set sub-surface to desynchronized mode
+func (object WlSubsurface) SetDesync() error {
+	if err := Object(object).checkRequest("set_desync", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5))
 }
 
+// WlFixes is the wl_fixes interface: wayland protocol fixes
 type WlFixes Object
 
-func (object WlFixes) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WlFixes) Interface() string {
+	return "wl_fixes"
 }
 
-func (object WlFixes) DestroyRegistry(registry WlRegistry) {
-	object.client.Write(wayland.NewMessage(object.id, 1, registry.id))
+// Destroy sends the destroy request: destroys this object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WlFixes) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// DestroyRegistry sends the destroy_registry request: destroy a wl_registry
+func (object WlFixes) DestroyRegistry(registry WlRegistry) error {
+	if err := Object(object).checkRequest("destroy_registry", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, registry.id))
 }
 
+// ZwpLinuxDmabufV1 is the zwp_linux_dmabuf_v1 interface
 type ZwpLinuxDmabufV1 Object
 
-func (object ZwpLinuxDmabufV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpLinuxDmabufV1) Interface() string {
+	return "zwp_linux_dmabuf_v1"
 }
 
-func (object ZwpLinuxDmabufV1) CreateParams() ZwpLinuxBufferParamsV1 {
-	paramsId := ZwpLinuxBufferParamsV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "zwp_linux_buffer_params_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpLinuxDmabufV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, paramsId.id))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// CreateParams sends the create_params request
+func (object ZwpLinuxDmabufV1) CreateParams() (ZwpLinuxBufferParamsV1, error) {
+	if err := Object(object).checkRequest("create_params", 1); err != nil {
+		return ZwpLinuxBufferParamsV1{}, err
+	}
+
+	paramsId := ZwpLinuxBufferParamsV1(object.client.newObject("zwp_linux_buffer_params_v1", object.version))
 
-	return paramsId
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, paramsId.id)); err != nil {
+		object.client.FreeObjectId(paramsId.id)
+		return ZwpLinuxBufferParamsV1{}, err
+	}
+
+	return paramsId, nil
 }
 
-func (object ZwpLinuxDmabufV1) GetDefaultFeedback() ZwpLinuxDmabufFeedbackV1 {
-	id := ZwpLinuxDmabufFeedbackV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "zwp_linux_dmabuf_feedback_v1",
-	})
+// GetDefaultFeedback sends the get_default_feedback request
+//
+// Available since version 4 of zwp_linux_dmabuf_v1.
+func (object ZwpLinuxDmabufV1) GetDefaultFeedback() (ZwpLinuxDmabufFeedbackV1, error) {
+	if err := Object(object).checkRequest("get_default_feedback", 4); err != nil {
+		return ZwpLinuxDmabufFeedbackV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id))
+	id := ZwpLinuxDmabufFeedbackV1(object.client.newObject("zwp_linux_dmabuf_feedback_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpLinuxDmabufFeedbackV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object ZwpLinuxDmabufV1) GetSurfaceFeedback(surface WlSurface) ZwpLinuxDmabufFeedbackV1 {
-	id := ZwpLinuxDmabufFeedbackV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "zwp_linux_dmabuf_feedback_v1",
-	})
+// GetSurfaceFeedback sends the get_surface_feedback request
+//
+// Available since version 4 of zwp_linux_dmabuf_v1.
+func (object ZwpLinuxDmabufV1) GetSurfaceFeedback(surface WlSurface) (ZwpLinuxDmabufFeedbackV1, error) {
+	if err := Object(object).checkRequest("get_surface_feedback", 4); err != nil {
+		return ZwpLinuxDmabufFeedbackV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 3, id.id, surface.id))
+	id := ZwpLinuxDmabufFeedbackV1(object.client.newObject("zwp_linux_dmabuf_feedback_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpLinuxDmabufFeedbackV1{}, err
+	}
+
+	return id, nil
 }
 
+// OnFormat registers a listener for the format event
 func (object ZwpLinuxDmabufV1) OnFormat(listener func(format uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnModifier registers a listener for the modifier event
+//
+// Available since version 3 of zwp_linux_dmabuf_v1.
 func (object ZwpLinuxDmabufV1) OnModifier(listener func(format uint32, modifierHi uint32, modifierLo uint32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("modifier", 3))
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// ZwpLinuxBufferParamsV1 is the zwp_linux_buffer_params_v1 interface
 type ZwpLinuxBufferParamsV1 Object
 
-func (object ZwpLinuxBufferParamsV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpLinuxBufferParamsV1) Interface() string {
+	return "zwp_linux_buffer_params_v1"
+}
+
+// ZwpLinuxBufferParamsV1Error is the error enum of zwp_linux_buffer_params_v1
+type ZwpLinuxBufferParamsV1Error uint32
+
+const (
+	ZwpLinuxBufferParamsV1ErrorAlreadyUsed ZwpLinuxBufferParamsV1Error = 0
+	ZwpLinuxBufferParamsV1ErrorPlaneIdx ZwpLinuxBufferParamsV1Error = 1
+	ZwpLinuxBufferParamsV1ErrorPlaneSet ZwpLinuxBufferParamsV1Error = 2
+	ZwpLinuxBufferParamsV1ErrorIncomplete ZwpLinuxBufferParamsV1Error = 3
+	ZwpLinuxBufferParamsV1ErrorInvalidFormat ZwpLinuxBufferParamsV1Error = 4
+	ZwpLinuxBufferParamsV1ErrorInvalidDimensions ZwpLinuxBufferParamsV1Error = 5
+	ZwpLinuxBufferParamsV1ErrorOutOfBounds ZwpLinuxBufferParamsV1Error = 6
+	ZwpLinuxBufferParamsV1ErrorInvalidWlBuffer ZwpLinuxBufferParamsV1Error = 7
+)
+
+func (value ZwpLinuxBufferParamsV1Error) String() string {
+	switch value {
+	case ZwpLinuxBufferParamsV1ErrorAlreadyUsed:
+		return "already_used"
+	case ZwpLinuxBufferParamsV1ErrorPlaneIdx:
+		return "plane_idx"
+	case ZwpLinuxBufferParamsV1ErrorPlaneSet:
+		return "plane_set"
+	case ZwpLinuxBufferParamsV1ErrorIncomplete:
+		return "incomplete"
+	case ZwpLinuxBufferParamsV1ErrorInvalidFormat:
+		return "invalid_format"
+	case ZwpLinuxBufferParamsV1ErrorInvalidDimensions:
+		return "invalid_dimensions"
+	case ZwpLinuxBufferParamsV1ErrorOutOfBounds:
+		return "out_of_bounds"
+	case ZwpLinuxBufferParamsV1ErrorInvalidWlBuffer:
+		return "invalid_wl_buffer"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object ZwpLinuxBufferParamsV1) Add(fd int, planeIdx uint32, offset uint32, stride uint32, modifierHi uint32, modifierLo uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, planeIdx, offset, stride, modifierHi, modifierLo).WithFds(fd))
-}
+// ZwpLinuxBufferParamsV1Flags is the flags enum of zwp_linux_buffer_params_v1
+type ZwpLinuxBufferParamsV1Flags uint32
 
-func (object ZwpLinuxBufferParamsV1) Create(width int32, height int32, format uint32, flags uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, width, height, format, flags))
-}
+const (
+	ZwpLinuxBufferParamsV1FlagsYInvert ZwpLinuxBufferParamsV1Flags = 1
+	ZwpLinuxBufferParamsV1FlagsInterlaced ZwpLinuxBufferParamsV1Flags = 2
+	ZwpLinuxBufferParamsV1FlagsBottomFirst ZwpLinuxBufferParamsV1Flags = 4
+)
 
-func (object ZwpLinuxBufferParamsV1) CreateImmed(width int32, height int32, format uint32, flags uint32) WlBuffer {
-	bufferId := WlBuffer(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_buffer",
+func (value ZwpLinuxBufferParamsV1Flags) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{1, "y_invert"},
+		{2, "interlaced"},
+		{4, "bottom_first"},
 	})
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 3, bufferId.id, width, height, format, flags))
-
-	return bufferId
+// Has reports whether all flags set in flag are also set in value
+func (value ZwpLinuxBufferParamsV1Flags) Has(flag ZwpLinuxBufferParamsV1Flags) bool {
+	return value&flag == flag
 }
 
-func (object ZwpLinuxBufferParamsV1) OnCreated(listener func(buffer WlBuffer)) chan struct{} {
-	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(WlBuffer(Object{client: object.client, id: object.client.NewObjectId(), iface: "wl_buffer"}))
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpLinuxBufferParamsV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// Add sends the add request
+func (object ZwpLinuxBufferParamsV1) Add(fd int, planeIdx uint32, offset uint32, stride uint32, modifierHi uint32, modifierLo uint32) error {
+	if err := Object(object).checkRequest("add", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, planeIdx, offset, stride, modifierHi, modifierLo).WithFds(fd))
+}
+
+// Create sends the create request
+func (object ZwpLinuxBufferParamsV1) Create(width int32, height int32, format uint32, flags ZwpLinuxBufferParamsV1Flags) error {
+	if err := Object(object).checkRequest("create", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, width, height, format, uint32(flags)))
+}
+
+// CreateImmed sends the create_immed request
+//
+// Available since version 2 of zwp_linux_buffer_params_v1.
+func (object ZwpLinuxBufferParamsV1) CreateImmed(width int32, height int32, format uint32, flags ZwpLinuxBufferParamsV1Flags) (WlBuffer, error) {
+	if err := Object(object).checkRequest("create_immed", 2); err != nil {
+		return WlBuffer{}, err
+	}
+
+	bufferId := WlBuffer(object.client.newObject("wl_buffer", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, bufferId.id, width, height, format, uint32(flags))); err != nil {
+		object.client.FreeObjectId(bufferId.id)
+		return WlBuffer{}, err
+	}
+
+	return bufferId, nil
+}
+
+// OnCreated registers a listener for the created event
+func (object ZwpLinuxBufferParamsV1) OnCreated(listener func(buffer WlBuffer)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(WlBuffer(object.client.resolve(message.ReadUint32())))
+	})
+}
+
+// OnFailed registers a listener for the failed event
 func (object ZwpLinuxBufferParamsV1) OnFailed(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ZwpLinuxDmabufFeedbackV1 is the zwp_linux_dmabuf_feedback_v1 interface
 type ZwpLinuxDmabufFeedbackV1 Object
 
-func (object ZwpLinuxDmabufFeedbackV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpLinuxDmabufFeedbackV1) Interface() string {
+	return "zwp_linux_dmabuf_feedback_v1"
+}
+
+// ZwpLinuxDmabufFeedbackV1TrancheFlags is the tranche_flags enum of zwp_linux_dmabuf_feedback_v1
+type ZwpLinuxDmabufFeedbackV1TrancheFlags uint32
+
+const (
+	ZwpLinuxDmabufFeedbackV1TrancheFlagsScanout ZwpLinuxDmabufFeedbackV1TrancheFlags = 1
+)
+
+func (value ZwpLinuxDmabufFeedbackV1TrancheFlags) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{1, "scanout"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value ZwpLinuxDmabufFeedbackV1TrancheFlags) Has(flag ZwpLinuxDmabufFeedbackV1TrancheFlags) bool {
+	return value&flag == flag
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpLinuxDmabufFeedbackV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnDone registers a listener for the done event
 func (object ZwpLinuxDmabufFeedbackV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFormatTable registers a listener for the format_table event
 func (object ZwpLinuxDmabufFeedbackV1) OnFormatTable(listener func(fd int, size uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadFd(), message.ReadUint32())
 	})
 }
 
-func (object ZwpLinuxDmabufFeedbackV1) OnMainDevice(listener func(device []uint32)) chan struct{} {
+// OnMainDevice registers a listener for the main_device event
+func (object ZwpLinuxDmabufFeedbackV1) OnMainDevice(listener func(device wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
+// OnTrancheDone registers a listener for the tranche_done event
 func (object ZwpLinuxDmabufFeedbackV1) OnTrancheDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
-func (object ZwpLinuxDmabufFeedbackV1) OnTrancheTargetDevice(listener func(device []uint32)) chan struct{} {
+// OnTrancheTargetDevice registers a listener for the tranche_target_device event
+func (object ZwpLinuxDmabufFeedbackV1) OnTrancheTargetDevice(listener func(device wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
-func (object ZwpLinuxDmabufFeedbackV1) OnTrancheFormats(listener func(indices []uint32)) chan struct{} {
+// OnTrancheFormats registers a listener for the tranche_formats event
+func (object ZwpLinuxDmabufFeedbackV1) OnTrancheFormats(listener func(indices wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
-func (object ZwpLinuxDmabufFeedbackV1) OnTrancheFlags(listener func(flags uint32)) chan struct{} {
+// OnTrancheFlags registers a listener for the tranche_flags event
+func (object ZwpLinuxDmabufFeedbackV1) OnTrancheFlags(listener func(flags ZwpLinuxDmabufFeedbackV1TrancheFlags)) chan struct{} {
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
-		listener(message.ReadUint32())
+		listener(ZwpLinuxDmabufFeedbackV1TrancheFlags(message.ReadUint32()))
 	})
 }
 
+// WpPresentation is the wp_presentation interface
 type WpPresentation Object
 
-func (object WpPresentation) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpPresentation) Interface() string {
+	return "wp_presentation"
 }
 
-func (object WpPresentation) Feedback(surface WlSurface) WpPresentationFeedback {
-	callback := WpPresentationFeedback(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_presentation_feedback",
-	})
+// WpPresentationError is the error enum of wp_presentation
+type WpPresentationError uint32
+
+const (
+	WpPresentationErrorInvalidTimestamp WpPresentationError = 0
+	WpPresentationErrorInvalidFlag WpPresentationError = 1
+)
+
+func (value WpPresentationError) String() string {
+	switch value {
+	case WpPresentationErrorInvalidTimestamp:
+		return "invalid_timestamp"
+	case WpPresentationErrorInvalidFlag:
+		return "invalid_flag"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpPresentation) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Feedback sends the feedback request
+func (object WpPresentation) Feedback(surface WlSurface) (WpPresentationFeedback, error) {
+	if err := Object(object).checkRequest("feedback", 1); err != nil {
+		return WpPresentationFeedback{}, err
+	}
+
+	callback := WpPresentationFeedback(object.client.newObject("wp_presentation_feedback", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, surface.id, callback.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, surface.id, callback.id)); err != nil {
+		object.client.FreeObjectId(callback.id)
+		return WpPresentationFeedback{}, err
+	}
 
-	return callback
+	return callback, nil
 }
 
+// OnClockId registers a listener for the clock_id event
 func (object WpPresentation) OnClockId(listener func(clkId uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpPresentationFeedback is the wp_presentation_feedback interface
 type WpPresentationFeedback Object
 
+// Interface returns the name of the interface, which is used by Bind
+func (WpPresentationFeedback) Interface() string {
+	return "wp_presentation_feedback"
+}
+
+// WpPresentationFeedbackKind is the kind enum of wp_presentation_feedback
+type WpPresentationFeedbackKind uint32
+
+const (
+	WpPresentationFeedbackKindVsync WpPresentationFeedbackKind = 0x1
+	WpPresentationFeedbackKindHwClock WpPresentationFeedbackKind = 0x2
+	WpPresentationFeedbackKindHwCompletion WpPresentationFeedbackKind = 0x4
+	WpPresentationFeedbackKindZeroCopy WpPresentationFeedbackKind = 0x8
+)
+
+func (value WpPresentationFeedbackKind) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0x1, "vsync"},
+		{0x2, "hw_clock"},
+		{0x4, "hw_completion"},
+		{0x8, "zero_copy"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value WpPresentationFeedbackKind) Has(flag WpPresentationFeedbackKind) bool {
+	return value&flag == flag
+}
+
+// OnSyncOutput registers a listener for the sync_output event
 func (object WpPresentationFeedback) OnSyncOutput(listener func(output WlOutput)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(WlOutput(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(WlOutput(object.client.resolve(message.ReadUint32())))
 	})
 }
 
-func (object WpPresentationFeedback) OnPresented(listener func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags uint32)) chan struct{} {
+// OnPresented registers a listener for the presented event
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpPresentationFeedback) OnPresented(listener func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32, refresh uint32, seqHi uint32, seqLo uint32, flags WpPresentationFeedbackKind)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), WpPresentationFeedbackKind(message.ReadUint32()))
 	})
 }
 
+// OnDiscarded registers a listener for the discarded event
+//
+// This is a destructor, after which the object can no longer be used.
 func (object WpPresentationFeedback) OnDiscarded(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ZwpTabletManagerV2 is the zwp_tablet_manager_v2 interface
 type ZwpTabletManagerV2 Object
 
-func (object ZwpTabletManagerV2) GetTabletSeat(seat WlSeat) ZwpTabletSeatV2 {
-	tabletSeat := ZwpTabletSeatV2(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "zwp_tablet_seat_v2",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletManagerV2) Interface() string {
+	return "zwp_tablet_manager_v2"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, tabletSeat.id, seat.id))
+// GetTabletSeat sends the get_tablet_seat request
+func (object ZwpTabletManagerV2) GetTabletSeat(seat WlSeat) (ZwpTabletSeatV2, error) {
+	if err := Object(object).checkRequest("get_tablet_seat", 1); err != nil {
+		return ZwpTabletSeatV2{}, err
+	}
+
+	tabletSeat := ZwpTabletSeatV2(object.client.newObject("zwp_tablet_seat_v2", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, tabletSeat.id, seat.id)); err != nil {
+		object.client.FreeObjectId(tabletSeat.id)
+		return ZwpTabletSeatV2{}, err
+	}
 
-	return tabletSeat
+	return tabletSeat, nil
 }
 
-func (object ZwpTabletManagerV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletManagerV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// ZwpTabletSeatV2 is the zwp_tablet_seat_v2 interface
 type ZwpTabletSeatV2 Object
 
-func (object ZwpTabletSeatV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletSeatV2) Interface() string {
+	return "zwp_tablet_seat_v2"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletSeatV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnTabletAdded registers a listener for the tablet_added event
 func (object ZwpTabletSeatV2) OnTabletAdded(listener func(id ZwpTabletV2)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(ZwpTabletV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_v2"}))
+		listener(ZwpTabletV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnToolAdded registers a listener for the tool_added event
 func (object ZwpTabletSeatV2) OnToolAdded(listener func(id ZwpTabletToolV2)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(ZwpTabletToolV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_tool_v2"}))
+		listener(ZwpTabletToolV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnPadAdded registers a listener for the pad_added event
 func (object ZwpTabletSeatV2) OnPadAdded(listener func(id ZwpTabletPadV2)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener(ZwpTabletPadV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_pad_v2"}))
+		listener(ZwpTabletPadV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// ZwpTabletToolV2 is the zwp_tablet_tool_v2 interface
 type ZwpTabletToolV2 Object
 
-func (object ZwpTabletToolV2) SetCursor(serial uint32, surface WlSurface, hotspotX int32, hotspotY int32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serial, surface.id, hotspotX, hotspotY))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletToolV2) Interface() string {
+	return "zwp_tablet_tool_v2"
+}
+
+// SetCursor sends the set_cursor request
+//
+// Arguments:
+//   - surface: nil for null
+func (object ZwpTabletToolV2) SetCursor(serial uint32, surface *WlSurface, hotspotX int32, hotspotY int32) error {
+	if err := Object(object).checkRequest("set_cursor", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, serial, nullableId(surface), hotspotX, hotspotY))
 }
 
-func (object ZwpTabletToolV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletToolV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnType registers a listener for the type event
 func (object ZwpTabletToolV2) OnType(listener func(toolType uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnHardwareSerial registers a listener for the hardware_serial event
 func (object ZwpTabletToolV2) OnHardwareSerial(listener func(hardwareSerialHi uint32, hardwareSerialLo uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnHardwareIdWacom registers a listener for the hardware_id_wacom event
 func (object ZwpTabletToolV2) OnHardwareIdWacom(listener func(hardwareIdHi uint32, hardwareIdLo uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnCapability registers a listener for the capability event
 func (object ZwpTabletToolV2) OnCapability(listener func(capability uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ZwpTabletToolV2) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnRemoved registers a listener for the removed event
 func (object ZwpTabletToolV2) OnRemoved(listener func()) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnProximityIn registers a listener for the proximity_in event
 func (object ZwpTabletToolV2) OnProximityIn(listener func(serial uint32, tablet ZwpTabletV2, surface WlSurface)) chan struct{} {
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
-		listener(message.ReadUint32(), ZwpTabletV2(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), ZwpTabletV2(object.client.resolve(message.ReadUint32())), WlSurface(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnProximityOut registers a listener for the proximity_out event
 func (object ZwpTabletToolV2) OnProximityOut(listener func()) chan struct{} {
 	return object.client.On(object.id, 7, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnDown registers a listener for the down event
 func (object ZwpTabletToolV2) OnDown(listener func(serial uint32)) chan struct{} {
 	return object.client.On(object.id, 8, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnUp registers a listener for the up event
 func (object ZwpTabletToolV2) OnUp(listener func()) chan struct{} {
 	return object.client.On(object.id, 9, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnMotion registers a listener for the motion event
 func (object ZwpTabletToolV2) OnMotion(listener func(x wayland.Fixed, y wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 10, func(message *wayland.Message) {
 		listener(message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnPressure registers a listener for the pressure event
 func (object ZwpTabletToolV2) OnPressure(listener func(pressure uint32)) chan struct{} {
 	return object.client.On(object.id, 11, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDistance registers a listener for the distance event
 func (object ZwpTabletToolV2) OnDistance(listener func(distance uint32)) chan struct{} {
 	return object.client.On(object.id, 12, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnTilt registers a listener for the tilt event
 func (object ZwpTabletToolV2) OnTilt(listener func(tiltX wayland.Fixed, tiltY wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 13, func(message *wayland.Message) {
 		listener(message.ReadFixed(), message.ReadFixed())
 	})
 }
 
+// OnRotation registers a listener for the rotation event
 func (object ZwpTabletToolV2) OnRotation(listener func(degrees wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 14, func(message *wayland.Message) {
 		listener(message.ReadFixed())
 	})
 }
 
+// OnSlider registers a listener for the slider event
 func (object ZwpTabletToolV2) OnSlider(listener func(position int32)) chan struct{} {
 	return object.client.On(object.id, 15, func(message *wayland.Message) {
 		listener(message.ReadInt32())
 	})
 }
 
+// OnWheel registers a listener for the wheel event
 func (object ZwpTabletToolV2) OnWheel(listener func(degrees wayland.Fixed, clicks int32)) chan struct{} {
 	return object.client.On(object.id, 16, func(message *wayland.Message) {
 		listener(message.ReadFixed(), message.ReadInt32())
 	})
 }
 
+// OnButton registers a listener for the button event
 func (object ZwpTabletToolV2) OnButton(listener func(serial uint32, button uint32, state uint32)) chan struct{} {
 	return object.client.On(object.id, 17, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnFrame registers a listener for the frame event
 func (object ZwpTabletToolV2) OnFrame(listener func(time uint32)) chan struct{} {
 	return object.client.On(object.id, 18, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ZwpTabletV2 is the zwp_tablet_v2 interface
 type ZwpTabletV2 Object
 
-func (object ZwpTabletV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletV2) Interface() string {
+	return "zwp_tablet_v2"
 }
 
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnName registers a listener for the name event
 func (object ZwpTabletV2) OnName(listener func(name string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnId registers a listener for the id event
 func (object ZwpTabletV2) OnId(listener func(vid uint32, pid uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnPath registers a listener for the path event
 func (object ZwpTabletV2) OnPath(listener func(path string)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ZwpTabletV2) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnRemoved registers a listener for the removed event
 func (object ZwpTabletV2) OnRemoved(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnBustype registers a listener for the bustype event
+//
+// Available since version 2 of zwp_tablet_v2.
 func (object ZwpTabletV2) OnBustype(listener func(bustype uint32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("bustype", 2))
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ZwpTabletPadRingV2 is the zwp_tablet_pad_ring_v2 interface
 type ZwpTabletPadRingV2 Object
 
-func (object ZwpTabletPadRingV2) SetFeedback(description string, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletPadRingV2) Interface() string {
+	return "zwp_tablet_pad_ring_v2"
+}
+
+// SetFeedback sends the set_feedback request
+func (object ZwpTabletPadRingV2) SetFeedback(description string, serial uint32) error {
+	if err := Object(object).checkRequest("set_feedback", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
 }
 
-func (object ZwpTabletPadRingV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletPadRingV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnSource registers a listener for the source event
 func (object ZwpTabletPadRingV2) OnSource(listener func(source uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnAngle registers a listener for the angle event
 func (object ZwpTabletPadRingV2) OnAngle(listener func(degrees wayland.Fixed)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadFixed())
 	})
 }
 
+// OnStop registers a listener for the stop event
 func (object ZwpTabletPadRingV2) OnStop(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFrame registers a listener for the frame event
 func (object ZwpTabletPadRingV2) OnFrame(listener func(time uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ZwpTabletPadStripV2 is the zwp_tablet_pad_strip_v2 interface
 type ZwpTabletPadStripV2 Object
 
-func (object ZwpTabletPadStripV2) SetFeedback(description string, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletPadStripV2) Interface() string {
+	return "zwp_tablet_pad_strip_v2"
+}
+
+// SetFeedback sends the set_feedback request
+func (object ZwpTabletPadStripV2) SetFeedback(description string, serial uint32) error {
+	if err := Object(object).checkRequest("set_feedback", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
 }
 
-func (object ZwpTabletPadStripV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletPadStripV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnSource registers a listener for the source event
 func (object ZwpTabletPadStripV2) OnSource(listener func(source uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnPosition registers a listener for the position event
 func (object ZwpTabletPadStripV2) OnPosition(listener func(position uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnStop registers a listener for the stop event
 func (object ZwpTabletPadStripV2) OnStop(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFrame registers a listener for the frame event
 func (object ZwpTabletPadStripV2) OnFrame(listener func(time uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ZwpTabletPadGroupV2 is the zwp_tablet_pad_group_v2 interface
 type ZwpTabletPadGroupV2 Object
 
-func (object ZwpTabletPadGroupV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletPadGroupV2) Interface() string {
+	return "zwp_tablet_pad_group_v2"
 }
 
-func (object ZwpTabletPadGroupV2) OnButtons(listener func(buttons []uint32)) chan struct{} {
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletPadGroupV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnButtons registers a listener for the buttons event
+func (object ZwpTabletPadGroupV2) OnButtons(listener func(buttons wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
+// OnRing registers a listener for the ring event
 func (object ZwpTabletPadGroupV2) OnRing(listener func(ring ZwpTabletPadRingV2)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(ZwpTabletPadRingV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_pad_ring_v2"}))
+		listener(ZwpTabletPadRingV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnStrip registers a listener for the strip event
 func (object ZwpTabletPadGroupV2) OnStrip(listener func(strip ZwpTabletPadStripV2)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener(ZwpTabletPadStripV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_pad_strip_v2"}))
+		listener(ZwpTabletPadStripV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnModes registers a listener for the modes event
 func (object ZwpTabletPadGroupV2) OnModes(listener func(modes uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ZwpTabletPadGroupV2) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnModeSwitch registers a listener for the mode_switch event
 func (object ZwpTabletPadGroupV2) OnModeSwitch(listener func(time uint32, serial uint32, mode uint32)) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnDial registers a listener for the dial event
+//
+// Available since version 2 of zwp_tablet_pad_group_v2.
 func (object ZwpTabletPadGroupV2) OnDial(listener func(dial ZwpTabletPadDialV2)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("dial", 2))
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
-		listener(ZwpTabletPadDialV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_pad_dial_v2"}))
+		listener(ZwpTabletPadDialV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// ZwpTabletPadV2 is the zwp_tablet_pad_v2 interface
 type ZwpTabletPadV2 Object
 
-func (object ZwpTabletPadV2) SetFeedback(button uint32, description string, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, button, description, serial))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletPadV2) Interface() string {
+	return "zwp_tablet_pad_v2"
+}
+
+// SetFeedback sends the set_feedback request
+func (object ZwpTabletPadV2) SetFeedback(button uint32, description string, serial uint32) error {
+	if err := Object(object).checkRequest("set_feedback", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, button, description, serial))
 }
 
-func (object ZwpTabletPadV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletPadV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnGroup registers a listener for the group event
 func (object ZwpTabletPadV2) OnGroup(listener func(padGroup ZwpTabletPadGroupV2)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(ZwpTabletPadGroupV2(Object{client: object.client, id: object.client.NewObjectId(), iface: "zwp_tablet_pad_group_v2"}))
+		listener(ZwpTabletPadGroupV2(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnPath registers a listener for the path event
 func (object ZwpTabletPadV2) OnPath(listener func(path string)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnButtons registers a listener for the buttons event
 func (object ZwpTabletPadV2) OnButtons(listener func(buttons uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ZwpTabletPadV2) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnButton registers a listener for the button event
 func (object ZwpTabletPadV2) OnButton(listener func(time uint32, button uint32, state uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnEnter registers a listener for the enter event
 func (object ZwpTabletPadV2) OnEnter(listener func(serial uint32, tablet ZwpTabletV2, surface WlSurface)) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
-		listener(message.ReadUint32(), ZwpTabletV2(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), ZwpTabletV2(object.client.resolve(message.ReadUint32())), WlSurface(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnLeave registers a listener for the leave event
 func (object ZwpTabletPadV2) OnLeave(listener func(serial uint32, surface WlSurface)) chan struct{} {
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
-		listener(message.ReadUint32(), WlSurface(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnRemoved registers a listener for the removed event
 func (object ZwpTabletPadV2) OnRemoved(listener func()) chan struct{} {
 	return object.client.On(object.id, 7, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ZwpTabletPadDialV2 is the zwp_tablet_pad_dial_v2 interface
 type ZwpTabletPadDialV2 Object
 
-func (object ZwpTabletPadDialV2) SetFeedback(description string, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTabletPadDialV2) Interface() string {
+	return "zwp_tablet_pad_dial_v2"
+}
+
+// SetFeedback sends the set_feedback request
+func (object ZwpTabletPadDialV2) SetFeedback(description string, serial uint32) error {
+	if err := Object(object).checkRequest("set_feedback", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, description, serial))
 }
 
-func (object ZwpTabletPadDialV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTabletPadDialV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnDelta registers a listener for the delta event
 func (object ZwpTabletPadDialV2) OnDelta(listener func(value120 int32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadInt32())
 	})
 }
 
+// OnFrame registers a listener for the frame event
 func (object ZwpTabletPadDialV2) OnFrame(listener func(time uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpViewporter is the wp_viewporter interface
 type WpViewporter Object
 
-func (object WpViewporter) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpViewporter) Interface() string {
+	return "wp_viewporter"
 }
 
-func (object WpViewporter) GetViewport(surface WlSurface) WpViewport {
-	id := WpViewport(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_viewport",
-	})
+// WpViewporterError is the error enum of wp_viewporter
+type WpViewporterError uint32
+
+const (
+	WpViewporterErrorViewportExists WpViewporterError = 0
+)
+
+func (value WpViewporterError) String() string {
+	switch value {
+	case WpViewporterErrorViewportExists:
+		return "viewport_exists"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpViewporter) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetViewport sends the get_viewport request
+func (object WpViewporter) GetViewport(surface WlSurface) (WpViewport, error) {
+	if err := Object(object).checkRequest("get_viewport", 1); err != nil {
+		return WpViewport{}, err
+	}
+
+	id := WpViewport(object.client.newObject("wp_viewport", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpViewport{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WpViewport is the wp_viewport interface
 type WpViewport Object
 
-func (object WpViewport) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpViewport) Interface() string {
+	return "wp_viewport"
+}
+
+// WpViewportError is the error enum of wp_viewport
+type WpViewportError uint32
+
+const (
+	WpViewportErrorBadValue WpViewportError = 0
+	WpViewportErrorBadSize WpViewportError = 1
+	WpViewportErrorOutOfBuffer WpViewportError = 2
+	WpViewportErrorNoSurface WpViewportError = 3
+)
+
+func (value WpViewportError) String() string {
+	switch value {
+	case WpViewportErrorBadValue:
+		return "bad_value"
+	case WpViewportErrorBadSize:
+		return "bad_size"
+	case WpViewportErrorOutOfBuffer:
+		return "out_of_buffer"
+	case WpViewportErrorNoSurface:
+		return "no_surface"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpViewport) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpViewport) SetSource(x wayland.Fixed, y wayland.Fixed, width wayland.Fixed, height wayland.Fixed) {
-	object.client.Write(wayland.NewMessage(object.id, 1, x, y, width, height))
+// SetSource sends the set_source request
+func (object WpViewport) SetSource(x wayland.Fixed, y wayland.Fixed, width wayland.Fixed, height wayland.Fixed) error {
+	if err := Object(object).checkRequest("set_source", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, x, y, width, height))
 }
 
-func (object WpViewport) SetDestination(width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, width, height))
+// SetDestination sends the set_destination request
+func (object WpViewport) SetDestination(width int32, height int32) error {
+	if err := Object(object).checkRequest("set_destination", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, width, height))
 }
 
+// XdgWmBase is the xdg_wm_base interface: create desktop-style surfaces
 type XdgWmBase Object
 
-func (object XdgWmBase) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgWmBase) Interface() string {
+	return "xdg_wm_base"
+}
+
+// XdgWmBaseError is the error enum of xdg_wm_base
+type XdgWmBaseError uint32
+
+const (
+	XdgWmBaseErrorRole XdgWmBaseError = 0
+	XdgWmBaseErrorDefunctSurfaces XdgWmBaseError = 1
+	XdgWmBaseErrorNotTheTopmostPopup XdgWmBaseError = 2
+	XdgWmBaseErrorInvalidPopupParent XdgWmBaseError = 3
+	XdgWmBaseErrorInvalidSurfaceState XdgWmBaseError = 4
+	XdgWmBaseErrorInvalidPositioner XdgWmBaseError = 5
+	XdgWmBaseErrorUnresponsive XdgWmBaseError = 6
+)
+
+func (value XdgWmBaseError) String() string {
+	switch value {
+	case XdgWmBaseErrorRole:
+		return "role"
+	case XdgWmBaseErrorDefunctSurfaces:
+		return "defunct_surfaces"
+	case XdgWmBaseErrorNotTheTopmostPopup:
+		return "not_the_topmost_popup"
+	case XdgWmBaseErrorInvalidPopupParent:
+		return "invalid_popup_parent"
+	case XdgWmBaseErrorInvalidSurfaceState:
+		return "invalid_surface_state"
+	case XdgWmBaseErrorInvalidPositioner:
+		return "invalid_positioner"
+	case XdgWmBaseErrorUnresponsive:
+		return "unresponsive"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object XdgWmBase) CreatePositioner() XdgPositioner {
-	id := XdgPositioner(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_positioner",
-	})
+// Destroy sends the destroy request: destroy xdg_wm_base
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgWmBase) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// CreatePositioner sends the create_positioner request: create a positioner object
+func (object XdgWmBase) CreatePositioner() (XdgPositioner, error) {
+	if err := Object(object).checkRequest("create_positioner", 1); err != nil {
+		return XdgPositioner{}, err
+	}
+
+	id := XdgPositioner(object.client.newObject("xdg_positioner", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgPositioner{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object XdgWmBase) GetXdgSurface(surface WlSurface) XdgSurface {
-	id := XdgSurface(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_surface",
-	})
+// GetXdgSurface sends the get_xdg_surface request: create a shell surface from a surface
+func (object XdgWmBase) GetXdgSurface(surface WlSurface) (XdgSurface, error) {
+	if err := Object(object).checkRequest("get_xdg_surface", 1); err != nil {
+		return XdgSurface{}, err
+	}
+
+	id := XdgSurface(object.client.newObject("xdg_surface", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgSurface{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object XdgWmBase) Pong(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, serial))
+// Pong sends the pong request: respond to a ping event
+//
+// Arguments:
+//   - serial: serial of the ping event
+func (object XdgWmBase) Pong(serial uint32) error {
+	if err := Object(object).checkRequest("pong", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, serial))
 }
 
+// OnPing registers a listener for the ping event: check if the client is alive
+//
+// Arguments:
+//   - serial: pass this to the pong request
 func (object XdgWmBase) OnPing(listener func(serial uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// XdgPositioner is the xdg_positioner interface: child surface positioner
 type XdgPositioner Object
 
-func (object XdgPositioner) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgPositioner) Interface() string {
+	return "xdg_positioner"
+}
+
+// XdgPositionerError is the error enum of xdg_positioner
+type XdgPositionerError uint32
+
+const (
+	XdgPositionerErrorInvalidInput XdgPositionerError = 0
+)
+
+func (value XdgPositionerError) String() string {
+	switch value {
+	case XdgPositionerErrorInvalidInput:
+		return "invalid_input"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// XdgPositionerAnchor is the anchor enum of xdg_positioner
+type XdgPositionerAnchor uint32
+
+const (
+	XdgPositionerAnchorNone XdgPositionerAnchor = 0
+	XdgPositionerAnchorTop XdgPositionerAnchor = 1
+	XdgPositionerAnchorBottom XdgPositionerAnchor = 2
+	XdgPositionerAnchorLeft XdgPositionerAnchor = 3
+	XdgPositionerAnchorRight XdgPositionerAnchor = 4
+	XdgPositionerAnchorTopLeft XdgPositionerAnchor = 5
+	XdgPositionerAnchorBottomLeft XdgPositionerAnchor = 6
+	XdgPositionerAnchorTopRight XdgPositionerAnchor = 7
+	XdgPositionerAnchorBottomRight XdgPositionerAnchor = 8
+)
+
+func (value XdgPositionerAnchor) String() string {
+	switch value {
+	case XdgPositionerAnchorNone:
+		return "none"
+	case XdgPositionerAnchorTop:
+		return "top"
+	case XdgPositionerAnchorBottom:
+		return "bottom"
+	case XdgPositionerAnchorLeft:
+		return "left"
+	case XdgPositionerAnchorRight:
+		return "right"
+	case XdgPositionerAnchorTopLeft:
+		return "top_left"
+	case XdgPositionerAnchorBottomLeft:
+		return "bottom_left"
+	case XdgPositionerAnchorTopRight:
+		return "top_right"
+	case XdgPositionerAnchorBottomRight:
+		return "bottom_right"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// XdgPositionerGravity is the gravity enum of xdg_positioner
+type XdgPositionerGravity uint32
+
+const (
+	XdgPositionerGravityNone XdgPositionerGravity = 0
+	XdgPositionerGravityTop XdgPositionerGravity = 1
+	XdgPositionerGravityBottom XdgPositionerGravity = 2
+	XdgPositionerGravityLeft XdgPositionerGravity = 3
+	XdgPositionerGravityRight XdgPositionerGravity = 4
+	XdgPositionerGravityTopLeft XdgPositionerGravity = 5
+	XdgPositionerGravityBottomLeft XdgPositionerGravity = 6
+	XdgPositionerGravityTopRight XdgPositionerGravity = 7
+	XdgPositionerGravityBottomRight XdgPositionerGravity = 8
+)
+
+func (value XdgPositionerGravity) String() string {
+	switch value {
+	case XdgPositionerGravityNone:
+		return "none"
+	case XdgPositionerGravityTop:
+		return "top"
+	case XdgPositionerGravityBottom:
+		return "bottom"
+	case XdgPositionerGravityLeft:
+		return "left"
+	case XdgPositionerGravityRight:
+		return "right"
+	case XdgPositionerGravityTopLeft:
+		return "top_left"
+	case XdgPositionerGravityBottomLeft:
+		return "bottom_left"
+	case XdgPositionerGravityTopRight:
+		return "top_right"
+	case XdgPositionerGravityBottomRight:
+		return "bottom_right"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// XdgPositionerConstraintAdjustment is the constraint_adjustment enum of xdg_positioner
+type XdgPositionerConstraintAdjustment uint32
+
+const (
+	XdgPositionerConstraintAdjustmentNone XdgPositionerConstraintAdjustment = 0
+	XdgPositionerConstraintAdjustmentSlideX XdgPositionerConstraintAdjustment = 1
+	XdgPositionerConstraintAdjustmentSlideY XdgPositionerConstraintAdjustment = 2
+	XdgPositionerConstraintAdjustmentFlipX XdgPositionerConstraintAdjustment = 4
+	XdgPositionerConstraintAdjustmentFlipY XdgPositionerConstraintAdjustment = 8
+	XdgPositionerConstraintAdjustmentResizeX XdgPositionerConstraintAdjustment = 16
+	XdgPositionerConstraintAdjustmentResizeY XdgPositionerConstraintAdjustment = 32
+)
+
+func (value XdgPositionerConstraintAdjustment) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0, "none"},
+		{1, "slide_x"},
+		{2, "slide_y"},
+		{4, "flip_x"},
+		{8, "flip_y"},
+		{16, "resize_x"},
+		{32, "resize_y"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value XdgPositionerConstraintAdjustment) Has(flag XdgPositionerConstraintAdjustment) bool {
+	return value&flag == flag
+}
+
+// Destroy sends the destroy request: destroy the xdg_positioner object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgPositioner) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgPositioner) SetSize(width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, width, height))
+// SetSize sends the set_size request: set the size of the to-be positioned rectangle
+func (object XdgPositioner) SetSize(width int32, height int32) error {
+	if err := Object(object).checkRequest("set_size", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, width, height))
 }
 
-func (object XdgPositioner) SetAnchorRect(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
+// SetAnchorRect sends the set_anchor_rect request: set the anchor rectangle within the parent surface
+func (object XdgPositioner) SetAnchorRect(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("set_anchor_rect", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
 }
 
-func (object XdgPositioner) SetAnchor(anchor uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, anchor))
+// SetAnchor sends the set_anchor request: set anchor rectangle anchor
+func (object XdgPositioner) SetAnchor(anchor XdgPositionerAnchor) error {
+	if err := Object(object).checkRequest("set_anchor", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, uint32(anchor)))
 }
 
-func (object XdgPositioner) SetGravity(gravity uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, gravity))
+// SetGravity sends the set_gravity request: set child surface gravity
+func (object XdgPositioner) SetGravity(gravity XdgPositionerGravity) error {
+	if err := Object(object).checkRequest("set_gravity", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, uint32(gravity)))
 }
 
-func (object XdgPositioner) SetConstraintAdjustment(constraintAdjustment uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 5, constraintAdjustment))
+// SetConstraintAdjustment sends the set_constraint_adjustment request: set the adjustment to be done when constrained
+func (object XdgPositioner) SetConstraintAdjustment(constraintAdjustment XdgPositionerConstraintAdjustment) error {
+	if err := Object(object).checkRequest("set_constraint_adjustment", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, uint32(constraintAdjustment)))
 }
 
-func (object XdgPositioner) SetOffset(x int32, y int32) {
-	object.client.Write(wayland.NewMessage(object.id, 6, x, y))
+// SetOffset sends the set_offset request: set surface position offset
+func (object XdgPositioner) SetOffset(x int32, y int32) error {
+	if err := Object(object).checkRequest("set_offset", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6, x, y))
 }
 
-func (object XdgPositioner) SetReactive() {
-	object.client.Write(wayland.NewMessage(object.id, 7))
+// SetReactive sends the set_reactive request: continuously reconstrain the surface
+//
+// Available since version 3 of xdg_positioner.
+func (object XdgPositioner) SetReactive() error {
+	if err := Object(object).checkRequest("set_reactive", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7))
 }
 
-func (object XdgPositioner) SetParentSize(parentWidth int32, parentHeight int32) {
-	object.client.Write(wayland.NewMessage(object.id, 8, parentWidth, parentHeight))
+// SetParentSize sends the set_parent_size request
+//
+// Available since version 3 of xdg_positioner.
+func (object XdgPositioner) SetParentSize(parentWidth int32, parentHeight int32) error {
+	if err := Object(object).checkRequest("set_parent_size", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 8, parentWidth, parentHeight))
 }
 
-func (object XdgPositioner) SetParentConfigure(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 9, serial))
+// SetParentConfigure sends the set_parent_configure request: set parent configure this is a response to
+//
+// Available since version 3 of xdg_positioner.
+func (object XdgPositioner) SetParentConfigure(serial uint32) error {
+	if err := Object(object).checkRequest("set_parent_configure", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 9, serial))
 }
 
+// XdgSurface is the xdg_surface interface: desktop user interface surface base interface
 type XdgSurface Object
 
-func (object XdgSurface) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgSurface) Interface() string {
+	return "xdg_surface"
+}
+
+// XdgSurfaceError is the error enum of xdg_surface
+type XdgSurfaceError uint32
+
+const (
+	XdgSurfaceErrorNotConstructed XdgSurfaceError = 1
+	XdgSurfaceErrorAlreadyConstructed XdgSurfaceError = 2
+	XdgSurfaceErrorUnconfiguredBuffer XdgSurfaceError = 3
+	XdgSurfaceErrorInvalidSerial XdgSurfaceError = 4
+	XdgSurfaceErrorInvalidSize XdgSurfaceError = 5
+	XdgSurfaceErrorDefunctRoleObject XdgSurfaceError = 6
+)
+
+func (value XdgSurfaceError) String() string {
+	switch value {
+	case XdgSurfaceErrorNotConstructed:
+		return "not_constructed"
+	case XdgSurfaceErrorAlreadyConstructed:
+		return "already_constructed"
+	case XdgSurfaceErrorUnconfiguredBuffer:
+		return "unconfigured_buffer"
+	case XdgSurfaceErrorInvalidSerial:
+		return "invalid_serial"
+	case XdgSurfaceErrorInvalidSize:
+		return "invalid_size"
+	case XdgSurfaceErrorDefunctRoleObject:
+		return "defunct_role_object"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-func (object XdgSurface) GetToplevel() XdgToplevel {
-	id := XdgToplevel(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_toplevel",
-	})
+// Destroy sends the destroy request: destroy the xdg_surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgSurface) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetToplevel sends the get_toplevel request: assign the xdg_toplevel surface role
+func (object XdgSurface) GetToplevel() (XdgToplevel, error) {
+	if err := Object(object).checkRequest("get_toplevel", 1); err != nil {
+		return XdgToplevel{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	id := XdgToplevel(object.client.newObject("xdg_toplevel", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgToplevel{}, err
+	}
+
+	return id, nil
 }
 
-func (object XdgSurface) GetPopup(parent XdgSurface, positioner XdgPositioner) XdgPopup {
-	id := XdgPopup(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_popup",
-	})
+// GetPopup sends the get_popup request: assign the xdg_popup surface role
+//
+// Arguments:
+//   - parent: nil for null
+func (object XdgSurface) GetPopup(parent *XdgSurface, positioner XdgPositioner) (XdgPopup, error) {
+	if err := Object(object).checkRequest("get_popup", 1); err != nil {
+		return XdgPopup{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id, parent.id, positioner.id))
+	id := XdgPopup(object.client.newObject("xdg_popup", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, nullableId(parent), positioner.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgPopup{}, err
+	}
+
+	return id, nil
 }
 
-func (object XdgSurface) SetWindowGeometry(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, x, y, width, height))
+// SetWindowGeometry sends the set_window_geometry request: set the new window geometry
+func (object XdgSurface) SetWindowGeometry(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("set_window_geometry", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, x, y, width, height))
 }
 
-func (object XdgSurface) AckConfigure(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, serial))
+// AckConfigure sends the ack_configure request: ack a configure event
+//
+// Arguments:
+//   - serial: the serial from the configure event
+func (object XdgSurface) AckConfigure(serial uint32) error {
+	if err := Object(object).checkRequest("ack_configure", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, serial))
 }
 
+// OnConfigure registers a listener for the configure event: suggest a surface change
+//
+// Arguments:
+//   - serial: serial of the configure event
 func (object XdgSurface) OnConfigure(listener func(serial uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// XdgToplevel is the xdg_toplevel interface: toplevel surface
 type XdgToplevel Object
 
-func (object XdgToplevel) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevel) Interface() string {
+	return "xdg_toplevel"
+}
+
+// XdgToplevelError is the error enum of xdg_toplevel
+type XdgToplevelError uint32
+
+const (
+	XdgToplevelErrorInvalidResizeEdge XdgToplevelError = 0
+	XdgToplevelErrorInvalidParent XdgToplevelError = 1
+	XdgToplevelErrorInvalidSize XdgToplevelError = 2
+)
+
+func (value XdgToplevelError) String() string {
+	switch value {
+	case XdgToplevelErrorInvalidResizeEdge:
+		return "invalid_resize_edge"
+	case XdgToplevelErrorInvalidParent:
+		return "invalid_parent"
+	case XdgToplevelErrorInvalidSize:
+		return "invalid_size"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// XdgToplevelResizeEdge is the resize_edge enum of xdg_toplevel
+type XdgToplevelResizeEdge uint32
+
+const (
+	XdgToplevelResizeEdgeNone XdgToplevelResizeEdge = 0
+	XdgToplevelResizeEdgeTop XdgToplevelResizeEdge = 1
+	XdgToplevelResizeEdgeBottom XdgToplevelResizeEdge = 2
+	XdgToplevelResizeEdgeLeft XdgToplevelResizeEdge = 4
+	XdgToplevelResizeEdgeTopLeft XdgToplevelResizeEdge = 5
+	XdgToplevelResizeEdgeBottomLeft XdgToplevelResizeEdge = 6
+	XdgToplevelResizeEdgeRight XdgToplevelResizeEdge = 8
+	XdgToplevelResizeEdgeTopRight XdgToplevelResizeEdge = 9
+	XdgToplevelResizeEdgeBottomRight XdgToplevelResizeEdge = 10
+)
+
+func (value XdgToplevelResizeEdge) String() string {
+	switch value {
+	case XdgToplevelResizeEdgeNone:
+		return "none"
+	case XdgToplevelResizeEdgeTop:
+		return "top"
+	case XdgToplevelResizeEdgeBottom:
+		return "bottom"
+	case XdgToplevelResizeEdgeLeft:
+		return "left"
+	case XdgToplevelResizeEdgeTopLeft:
+		return "top_left"
+	case XdgToplevelResizeEdgeBottomLeft:
+		return "bottom_left"
+	case XdgToplevelResizeEdgeRight:
+		return "right"
+	case XdgToplevelResizeEdgeTopRight:
+		return "top_right"
+	case XdgToplevelResizeEdgeBottomRight:
+		return "bottom_right"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// XdgToplevelState is the state enum of xdg_toplevel: types of state on the surface
+type XdgToplevelState uint32
+
+const (
+	// the surface is maximized
+	XdgToplevelStateMaximized XdgToplevelState = 1
+	// the surface is fullscreen
+	XdgToplevelStateFullscreen XdgToplevelState = 2
+	// the surface is being resized
+	XdgToplevelStateResizing XdgToplevelState = 3
+	// the surface is now activated
+	XdgToplevelStateActivated XdgToplevelState = 4
+	// Available since version 2 of xdg_toplevel.
+	XdgToplevelStateTiledLeft XdgToplevelState = 5
+	// Available since version 2 of xdg_toplevel.
+	XdgToplevelStateTiledRight XdgToplevelState = 6
+	// Available since version 2 of xdg_toplevel.
+	XdgToplevelStateTiledTop XdgToplevelState = 7
+	// Available since version 2 of xdg_toplevel.
+	XdgToplevelStateTiledBottom XdgToplevelState = 8
+	// Available since version 6 of xdg_toplevel.
+	XdgToplevelStateSuspended XdgToplevelState = 9
+	// Available since version 7 of xdg_toplevel.
+	XdgToplevelStateConstrainedLeft XdgToplevelState = 10
+	// Available since version 7 of xdg_toplevel.
+	XdgToplevelStateConstrainedRight XdgToplevelState = 11
+	// Available since version 7 of xdg_toplevel.
+	XdgToplevelStateConstrainedTop XdgToplevelState = 12
+	// Available since version 7 of xdg_toplevel.
+	XdgToplevelStateConstrainedBottom XdgToplevelState = 13
+)
+
+func (value XdgToplevelState) String() string {
+	switch value {
+	case XdgToplevelStateMaximized:
+		return "maximized"
+	case XdgToplevelStateFullscreen:
+		return "fullscreen"
+	case XdgToplevelStateResizing:
+		return "resizing"
+	case XdgToplevelStateActivated:
+		return "activated"
+	case XdgToplevelStateTiledLeft:
+		return "tiled_left"
+	case XdgToplevelStateTiledRight:
+		return "tiled_right"
+	case XdgToplevelStateTiledTop:
+		return "tiled_top"
+	case XdgToplevelStateTiledBottom:
+		return "tiled_bottom"
+	case XdgToplevelStateSuspended:
+		return "suspended"
+	case XdgToplevelStateConstrainedLeft:
+		return "constrained_left"
+	case XdgToplevelStateConstrainedRight:
+		return "constrained_right"
+	case XdgToplevelStateConstrainedTop:
+		return "constrained_top"
+	case XdgToplevelStateConstrainedBottom:
+		return "constrained_bottom"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of xdg_toplevel value was introduced in
+func (value XdgToplevelState) since() uint32 {
+	switch value {
+	case XdgToplevelStateTiledLeft:
+		return 2
+	case XdgToplevelStateTiledRight:
+		return 2
+	case XdgToplevelStateTiledTop:
+		return 2
+	case XdgToplevelStateTiledBottom:
+		return 2
+	case XdgToplevelStateSuspended:
+		return 6
+	case XdgToplevelStateConstrainedLeft:
+		return 7
+	case XdgToplevelStateConstrainedRight:
+		return 7
+	case XdgToplevelStateConstrainedTop:
+		return 7
+	case XdgToplevelStateConstrainedBottom:
+		return 7
+	}
+	return 1
+}
+
+// XdgToplevelWmCapabilities is the wm_capabilities enum of xdg_toplevel
+type XdgToplevelWmCapabilities uint32
+
+const (
+	XdgToplevelWmCapabilitiesWindowMenu XdgToplevelWmCapabilities = 1
+	XdgToplevelWmCapabilitiesMaximize XdgToplevelWmCapabilities = 2
+	XdgToplevelWmCapabilitiesFullscreen XdgToplevelWmCapabilities = 3
+	XdgToplevelWmCapabilitiesMinimize XdgToplevelWmCapabilities = 4
+)
+
+func (value XdgToplevelWmCapabilities) String() string {
+	switch value {
+	case XdgToplevelWmCapabilitiesWindowMenu:
+		return "window_menu"
+	case XdgToplevelWmCapabilitiesMaximize:
+		return "maximize"
+	case XdgToplevelWmCapabilitiesFullscreen:
+		return "fullscreen"
+	case XdgToplevelWmCapabilitiesMinimize:
+		return "minimize"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: destroy the xdg_toplevel
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevel) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgToplevel) SetParent(parent XdgToplevel) {
-	object.client.Write(wayland.NewMessage(object.id, 1, parent.id))
+// SetParent sends the set_parent request: set the parent of this surface
+//
+// Arguments:
+//   - parent: nil for null
+func (object XdgToplevel) SetParent(parent *XdgToplevel) error {
+	if err := Object(object).checkRequest("set_parent", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, nullableId(parent)))
 }
 
-func (object XdgToplevel) SetTitle(title string) {
-	object.client.Write(wayland.NewMessage(object.id, 2, title))
+// SetTitle sends the set_title request: set surface title
+func (object XdgToplevel) SetTitle(title string) error {
+	if err := Object(object).checkRequest("set_title", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, title))
 }
 
-func (object XdgToplevel) SetAppId(appId string) {
-	object.client.Write(wayland.NewMessage(object.id, 3, appId))
+// SetAppId sends the set_app_id request: set application ID
+func (object XdgToplevel) SetAppId(appId string) error {
+	if err := Object(object).checkRequest("set_app_id", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, appId))
 }
 
-func (object XdgToplevel) ShowWindowMenu(seat WlSeat, serial uint32, x int32, y int32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, seat.id, serial, x, y))
+// ShowWindowMenu sends the show_window_menu request: show the window menu
+func (object XdgToplevel) ShowWindowMenu(seat WlSeat, serial uint32, x int32, y int32) error {
+	if err := Object(object).checkRequest("show_window_menu", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, seat.id, serial, x, y))
 }
 
-func (object XdgToplevel) Move(seat WlSeat, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 5, seat.id, serial))
+// Move sends the move request: start an interactive move
+func (object XdgToplevel) Move(seat WlSeat, serial uint32) error {
+	if err := Object(object).checkRequest("move", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, seat.id, serial))
 }
 
-func (object XdgToplevel) Resize(seat WlSeat, serial uint32, edges uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 6, seat.id, serial, edges))
+// Resize sends the resize request: start an interactive resize
+func (object XdgToplevel) Resize(seat WlSeat, serial uint32, edges XdgToplevelResizeEdge) error {
+	if err := Object(object).checkRequest("resize", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6, seat.id, serial, uint32(edges)))
 }
 
-func (object XdgToplevel) SetMaxSize(width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 7, width, height))
+// SetMaxSize sends the set_max_size request: set the maximum size
+func (object XdgToplevel) SetMaxSize(width int32, height int32) error {
+	if err := Object(object).checkRequest("set_max_size", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7, width, height))
 }
 
-func (object XdgToplevel) SetMinSize(width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 8, width, height))
+// SetMinSize sends the set_min_size request: set the minimum size
+func (object XdgToplevel) SetMinSize(width int32, height int32) error {
+	if err := Object(object).checkRequest("set_min_size", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 8, width, height))
 }
 
-func (object XdgToplevel) SetMaximized() {
-	object.client.Write(wayland.NewMessage(object.id, 9))
+// SetMaximized sends the set_maximized request: maximize the window
+func (object XdgToplevel) SetMaximized() error {
+	if err := Object(object).checkRequest("set_maximized", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 9))
 }
 
-func (object XdgToplevel) UnsetMaximized() {
-	object.client.Write(wayland.NewMessage(object.id, 10))
+// UnsetMaximized sends the unset_maximized request: unmaximize the window
+func (object XdgToplevel) UnsetMaximized() error {
+	if err := Object(object).checkRequest("unset_maximized", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 10))
 }
 
-func (object XdgToplevel) SetFullscreen(output WlOutput) {
-	object.client.Write(wayland.NewMessage(object.id, 11, output.id))
+// SetFullscreen sends the set_fullscreen request: set the window as fullscreen on an output
+//
+// Arguments:
+//   - output: nil for null
+func (object XdgToplevel) SetFullscreen(output *WlOutput) error {
+	if err := Object(object).checkRequest("set_fullscreen", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 11, nullableId(output)))
 }
 
-func (object XdgToplevel) UnsetFullscreen() {
-	object.client.Write(wayland.NewMessage(object.id, 12))
+// UnsetFullscreen sends the unset_fullscreen request: unset the window as fullscreen
+func (object XdgToplevel) UnsetFullscreen() error {
+	if err := Object(object).checkRequest("unset_fullscreen", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 12))
 }
 
-func (object XdgToplevel) SetMinimized() {
-	object.client.Write(wayland.NewMessage(object.id, 13))
+// SetMinimized sends the set_minimized request: set the window as minimized
+func (object XdgToplevel) SetMinimized() error {
+	if err := Object(object).checkRequest("set_minimized", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 13))
 }
 
-func (object XdgToplevel) OnConfigure(listener func(width int32, height int32, states []uint32)) chan struct{} {
+// OnConfigure registers a listener for the configure event: suggest a surface change
+func (object XdgToplevel) OnConfigure(listener func(width int32, height int32, states wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32(), message.ReadArray())
 	})
 }
 
+// OnClose registers a listener for the close event: surface wants to be closed
 func (object XdgToplevel) OnClose(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnConfigureBounds registers a listener for the configure_bounds event: recommended window geometry bounds
+//
+// Available since version 4 of xdg_toplevel.
 func (object XdgToplevel) OnConfigureBounds(listener func(width int32, height int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("configure_bounds", 4))
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32())
 	})
 }
 
-func (object XdgToplevel) OnWmCapabilities(listener func(capabilities []uint32)) chan struct{} {
+// OnWmCapabilities registers a listener for the wm_capabilities event: compositor capabilities
+//
+// Available since version 5 of xdg_toplevel.
+func (object XdgToplevel) OnWmCapabilities(listener func(capabilities wayland.Array)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("wm_capabilities", 5))
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
+// XdgPopup is the xdg_popup interface: short-lived, popup surfaces for menus
 type XdgPopup Object
 
-func (object XdgPopup) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgPopup) Interface() string {
+	return "xdg_popup"
+}
+
+// XdgPopupError is the error enum of xdg_popup
+type XdgPopupError uint32
+
+const (
+	XdgPopupErrorInvalidGrab XdgPopupError = 0
+)
+
+func (value XdgPopupError) String() string {
+	switch value {
+	case XdgPopupErrorInvalidGrab:
+		return "invalid_grab"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: remove xdg_popup interface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgPopup) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgPopup) Grab(seat WlSeat, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, seat.id, serial))
+// Grab sends the grab request: make the popup take an explicit grab
+func (object XdgPopup) Grab(seat WlSeat, serial uint32) error {
+	if err := Object(object).checkRequest("grab", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, seat.id, serial))
 }
 
-func (object XdgPopup) Reposition(positioner XdgPositioner, token uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, positioner.id, token))
+// Reposition sends the reposition request: recalculate the popup's location
+//
+// Available since version 3 of xdg_popup.
+func (object XdgPopup) Reposition(positioner XdgPositioner, token uint32) error {
+	if err := Object(object).checkRequest("reposition", 3); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, positioner.id, token))
 }
 
+// OnConfigure registers a listener for the configure event: configure the popup surface
 func (object XdgPopup) OnConfigure(listener func(x int32, y int32, width int32, height int32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnPopupDone registers a listener for the popup_done event: popup interaction is done
 func (object XdgPopup) OnPopupDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnRepositioned registers a listener for the repositioned event: signal the completion of a repositioned request
+//
+// Available since version 3 of xdg_popup.
 func (object XdgPopup) OnRepositioned(listener func(token uint32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("repositioned", 3))
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpAlphaModifierV1 is the wp_alpha_modifier_v1 interface
 type WpAlphaModifierV1 Object
 
-func (object WpAlphaModifierV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpAlphaModifierV1) Interface() string {
+	return "wp_alpha_modifier_v1"
 }
 
-func (object WpAlphaModifierV1) GetSurface(surface WlSurface) WpAlphaModifierSurfaceV1 {
-	id := WpAlphaModifierSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_alpha_modifier_surface_v1",
-	})
-
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpAlphaModifierV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-type WpAlphaModifierSurfaceV1 Object
+// GetSurface sends the get_surface request
+func (object WpAlphaModifierV1) GetSurface(surface WlSurface) (WpAlphaModifierSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_surface", 1); err != nil {
+		return WpAlphaModifierSurfaceV1{}, err
+	}
 
-func (object WpAlphaModifierSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
-}
+	id := WpAlphaModifierSurfaceV1(object.client.newObject("wp_alpha_modifier_surface_v1", object.version))
 
-func (object WpAlphaModifierSurfaceV1) SetMultiplier(factor uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, factor))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpAlphaModifierSurfaceV1{}, err
+	}
+
+	return id, nil
 }
 
-type WpColorManagerV1 Object
+// WpAlphaModifierSurfaceV1 is the wp_alpha_modifier_surface_v1 interface
+type WpAlphaModifierSurfaceV1 Object
 
-func (object WpColorManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpAlphaModifierSurfaceV1) Interface() string {
+	return "wp_alpha_modifier_surface_v1"
 }
 
-func (object WpColorManagerV1) GetOutput(output WlOutput) WpColorManagementOutputV1 {
-	id := WpColorManagementOutputV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_color_management_output_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpAlphaModifierSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, output.id))
+// SetMultiplier sends the set_multiplier request
+func (object WpAlphaModifierSurfaceV1) SetMultiplier(factor uint32) error {
+	if err := Object(object).checkRequest("set_multiplier", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.Write(wayland.NewMessage(object.id, 1, factor))
 }
 
-func (object WpColorManagerV1) GetSurface(surface WlSurface) WpColorManagementSurfaceV1 {
-	id := WpColorManagementSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_color_management_surface_v1",
-	})
+// WpColorManagerV1 is the wp_color_manager_v1 interface
+type WpColorManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorManagerV1) Interface() string {
+	return "wp_color_manager_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id, surface.id))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpColorManagerV1) GetSurfaceFeedback(surface WlSurface) WpColorManagementSurfaceFeedbackV1 {
-	id := WpColorManagementSurfaceFeedbackV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_color_management_surface_feedback_v1",
-	})
+// GetOutput sends the get_output request
+func (object WpColorManagerV1) GetOutput(output WlOutput) (WpColorManagementOutputV1, error) {
+	if err := Object(object).checkRequest("get_output", 1); err != nil {
+		return WpColorManagementOutputV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 3, id.id, surface.id))
+	id := WpColorManagementOutputV1(object.client.newObject("wp_color_management_output_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, output.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpColorManagementOutputV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object WpColorManagerV1) CreateIccCreator() WpImageDescriptionCreatorIccV1 {
-	obj := WpImageDescriptionCreatorIccV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_creator_icc_v1",
-	})
+// GetSurface sends the get_surface request
+func (object WpColorManagerV1) GetSurface(surface WlSurface) (WpColorManagementSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_surface", 1); err != nil {
+		return WpColorManagementSurfaceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 4, obj.id))
+	id := WpColorManagementSurfaceV1(object.client.newObject("wp_color_management_surface_v1", object.version))
 
-	return obj
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpColorManagementSurfaceV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object WpColorManagerV1) CreateParametricCreator() WpImageDescriptionCreatorParamsV1 {
-	obj := WpImageDescriptionCreatorParamsV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_creator_params_v1",
-	})
+// GetSurfaceFeedback sends the get_surface_feedback request
+func (object WpColorManagerV1) GetSurfaceFeedback(surface WlSurface) (WpColorManagementSurfaceFeedbackV1, error) {
+	if err := Object(object).checkRequest("get_surface_feedback", 1); err != nil {
+		return WpColorManagementSurfaceFeedbackV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 5, obj.id))
+	id := WpColorManagementSurfaceFeedbackV1(object.client.newObject("wp_color_management_surface_feedback_v1", object.version))
 
-	return obj
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpColorManagementSurfaceFeedbackV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object WpColorManagerV1) CreateWindowsScrgb() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// CreateIccCreator sends the create_icc_creator request
+func (object WpColorManagerV1) CreateIccCreator() (WpImageDescriptionCreatorIccV1, error) {
+	if err := Object(object).checkRequest("create_icc_creator", 1); err != nil {
+		return WpImageDescriptionCreatorIccV1{}, err
+	}
+
+	obj := WpImageDescriptionCreatorIccV1(object.client.newObject("wp_image_description_creator_icc_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 4, obj.id)); err != nil {
+		object.client.FreeObjectId(obj.id)
+		return WpImageDescriptionCreatorIccV1{}, err
+	}
+
+	return obj, nil
+}
+
+// CreateParametricCreator sends the create_parametric_creator request
+func (object WpColorManagerV1) CreateParametricCreator() (WpImageDescriptionCreatorParamsV1, error) {
+	if err := Object(object).checkRequest("create_parametric_creator", 1); err != nil {
+		return WpImageDescriptionCreatorParamsV1{}, err
+	}
+
+	obj := WpImageDescriptionCreatorParamsV1(object.client.newObject("wp_image_description_creator_params_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 6, imageDescription.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 5, obj.id)); err != nil {
+		object.client.FreeObjectId(obj.id)
+		return WpImageDescriptionCreatorParamsV1{}, err
+	}
+
+	return obj, nil
+}
+
+// CreateWindowsScrgb sends the create_windows_scrgb request
+func (object WpColorManagerV1) CreateWindowsScrgb() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("create_windows_scrgb", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
+
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 6, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
 
-	return imageDescription
+	return imageDescription, nil
 }
 
+// OnSupportedIntent registers a listener for the supported_intent event
 func (object WpColorManagerV1) OnSupportedIntent(listener func(renderIntent uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnSupportedFeature registers a listener for the supported_feature event
 func (object WpColorManagerV1) OnSupportedFeature(listener func(feature uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnSupportedTfNamed registers a listener for the supported_tf_named event
 func (object WpColorManagerV1) OnSupportedTfNamed(listener func(tf uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnSupportedPrimariesNamed registers a listener for the supported_primaries_named event
 func (object WpColorManagerV1) OnSupportedPrimariesNamed(listener func(primaries uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object WpColorManagerV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpColorManagementOutputV1 is the wp_color_management_output_v1 interface
 type WpColorManagementOutputV1 Object
 
-func (object WpColorManagementOutputV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorManagementOutputV1) Interface() string {
+	return "wp_color_management_output_v1"
 }
 
-func (object WpColorManagementOutputV1) GetImageDescription() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorManagementOutputV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id))
+// GetImageDescription sends the get_image_description request
+func (object WpColorManagementOutputV1) GetImageDescription() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("get_image_description", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
+
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
 
-	return imageDescription
+	return imageDescription, nil
 }
 
+// OnImageDescriptionChanged registers a listener for the image_description_changed event
 func (object WpColorManagementOutputV1) OnImageDescriptionChanged(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpColorManagementSurfaceV1 is the wp_color_management_surface_v1 interface
 type WpColorManagementSurfaceV1 Object
 
-func (object WpColorManagementSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorManagementSurfaceV1) Interface() string {
+	return "wp_color_management_surface_v1"
 }
 
-func (object WpColorManagementSurfaceV1) SetImageDescription(imageDescription WpImageDescriptionV1, renderIntent uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id, renderIntent))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorManagementSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetImageDescription sends the set_image_description request
+func (object WpColorManagementSurfaceV1) SetImageDescription(imageDescription WpImageDescriptionV1, renderIntent uint32) error {
+	if err := Object(object).checkRequest("set_image_description", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id, renderIntent))
 }
 
-func (object WpColorManagementSurfaceV1) UnsetImageDescription() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// UnsetImageDescription sends the unset_image_description request
+func (object WpColorManagementSurfaceV1) UnsetImageDescription() error {
+	if err := Object(object).checkRequest("unset_image_description", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2))
 }
 
+// WpColorManagementSurfaceFeedbackV1 is the wp_color_management_surface_feedback_v1 interface
 type WpColorManagementSurfaceFeedbackV1 Object
 
-func (object WpColorManagementSurfaceFeedbackV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorManagementSurfaceFeedbackV1) Interface() string {
+	return "wp_color_management_surface_feedback_v1"
 }
 
-func (object WpColorManagementSurfaceFeedbackV1) GetPreferred() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorManagementSurfaceFeedbackV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id))
+// GetPreferred sends the get_preferred request
+func (object WpColorManagementSurfaceFeedbackV1) GetPreferred() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("get_preferred", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
+
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
 
-	return imageDescription
+	return imageDescription, nil
 }
 
-func (object WpColorManagementSurfaceFeedbackV1) GetPreferredParametric() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// GetPreferredParametric sends the get_preferred_parametric request
+func (object WpColorManagementSurfaceFeedbackV1) GetPreferredParametric() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("get_preferred_parametric", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, imageDescription.id))
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
 
-	return imageDescription
+	return imageDescription, nil
 }
 
+// OnPreferredChanged registers a listener for the preferred_changed event
 func (object WpColorManagementSurfaceFeedbackV1) OnPreferredChanged(listener func(identity uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpImageDescriptionCreatorIccV1 is the wp_image_description_creator_icc_v1 interface
 type WpImageDescriptionCreatorIccV1 Object
 
-func (object WpImageDescriptionCreatorIccV1) Create() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WpImageDescriptionCreatorIccV1) Interface() string {
+	return "wp_image_description_creator_icc_v1"
+}
+
+// Create sends the create request
+func (object WpImageDescriptionCreatorIccV1) Create() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("create", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, imageDescription.id))
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
 
-	return imageDescription
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
+
+	return imageDescription, nil
 }
 
-func (object WpImageDescriptionCreatorIccV1) SetIccFile(iccProfile int, offset uint32, length uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, offset, length).WithFds(iccProfile))
+// SetIccFile sends the set_icc_file request
+func (object WpImageDescriptionCreatorIccV1) SetIccFile(iccProfile int, offset uint32, length uint32) error {
+	if err := Object(object).checkRequest("set_icc_file", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, offset, length).WithFds(iccProfile))
 }
 
+// WpImageDescriptionCreatorParamsV1 is the wp_image_description_creator_params_v1 interface
 type WpImageDescriptionCreatorParamsV1 Object
 
-func (object WpImageDescriptionCreatorParamsV1) Create() WpImageDescriptionV1 {
-	imageDescription := WpImageDescriptionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WpImageDescriptionCreatorParamsV1) Interface() string {
+	return "wp_image_description_creator_params_v1"
+}
+
+// Create sends the create request
+func (object WpImageDescriptionCreatorParamsV1) Create() (WpImageDescriptionV1, error) {
+	if err := Object(object).checkRequest("create", 1); err != nil {
+		return WpImageDescriptionV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, imageDescription.id))
+	imageDescription := WpImageDescriptionV1(object.client.newObject("wp_image_description_v1", object.version))
 
-	return imageDescription
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, imageDescription.id)); err != nil {
+		object.client.FreeObjectId(imageDescription.id)
+		return WpImageDescriptionV1{}, err
+	}
+
+	return imageDescription, nil
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetTfNamed(tf uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, tf))
+// SetTfNamed sends the set_tf_named request
+func (object WpImageDescriptionCreatorParamsV1) SetTfNamed(tf uint32) error {
+	if err := Object(object).checkRequest("set_tf_named", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, tf))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetTfPower(eexp uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, eexp))
+// SetTfPower sends the set_tf_power request
+func (object WpImageDescriptionCreatorParamsV1) SetTfPower(eexp uint32) error {
+	if err := Object(object).checkRequest("set_tf_power", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, eexp))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetPrimariesNamed(primaries uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, primaries))
+// SetPrimariesNamed sends the set_primaries_named request
+func (object WpImageDescriptionCreatorParamsV1) SetPrimariesNamed(primaries uint32) error {
+	if err := Object(object).checkRequest("set_primaries_named", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, primaries))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetPrimaries(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32) {
-	object.client.Write(wayland.NewMessage(object.id, 4, rX, rY, gX, gY, bX, bY, wX, wY))
+// SetPrimaries sends the set_primaries request
+func (object WpImageDescriptionCreatorParamsV1) SetPrimaries(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32) error {
+	if err := Object(object).checkRequest("set_primaries", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, rX, rY, gX, gY, bX, bY, wX, wY))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetLuminances(minLum uint32, maxLum uint32, referenceLum uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 5, minLum, maxLum, referenceLum))
+// SetLuminances sends the set_luminances request
+func (object WpImageDescriptionCreatorParamsV1) SetLuminances(minLum uint32, maxLum uint32, referenceLum uint32) error {
+	if err := Object(object).checkRequest("set_luminances", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, minLum, maxLum, referenceLum))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetMasteringDisplayPrimaries(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32) {
-	object.client.Write(wayland.NewMessage(object.id, 6, rX, rY, gX, gY, bX, bY, wX, wY))
+// SetMasteringDisplayPrimaries sends the set_mastering_display_primaries request
+func (object WpImageDescriptionCreatorParamsV1) SetMasteringDisplayPrimaries(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32) error {
+	if err := Object(object).checkRequest("set_mastering_display_primaries", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6, rX, rY, gX, gY, bX, bY, wX, wY))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetMasteringLuminance(minLum uint32, maxLum uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 7, minLum, maxLum))
+// SetMasteringLuminance sends the set_mastering_luminance request
+func (object WpImageDescriptionCreatorParamsV1) SetMasteringLuminance(minLum uint32, maxLum uint32) error {
+	if err := Object(object).checkRequest("set_mastering_luminance", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7, minLum, maxLum))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetMaxCll(maxCll uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 8, maxCll))
+// SetMaxCll sends the set_max_cll request
+func (object WpImageDescriptionCreatorParamsV1) SetMaxCll(maxCll uint32) error {
+	if err := Object(object).checkRequest("set_max_cll", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 8, maxCll))
 }
 
-func (object WpImageDescriptionCreatorParamsV1) SetMaxFall(maxFall uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 9, maxFall))
+// SetMaxFall sends the set_max_fall request
+func (object WpImageDescriptionCreatorParamsV1) SetMaxFall(maxFall uint32) error {
+	if err := Object(object).checkRequest("set_max_fall", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 9, maxFall))
 }
 
+// WpImageDescriptionV1 is the wp_image_description_v1 interface
 type WpImageDescriptionV1 Object
 
-func (object WpImageDescriptionV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpImageDescriptionV1) Interface() string {
+	return "wp_image_description_v1"
 }
 
-func (object WpImageDescriptionV1) GetInformation() WpImageDescriptionInfoV1 {
-	information := WpImageDescriptionInfoV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_image_description_info_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpImageDescriptionV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, information.id))
+// GetInformation sends the get_information request
+func (object WpImageDescriptionV1) GetInformation() (WpImageDescriptionInfoV1, error) {
+	if err := Object(object).checkRequest("get_information", 1); err != nil {
+		return WpImageDescriptionInfoV1{}, err
+	}
+
+	information := WpImageDescriptionInfoV1(object.client.newObject("wp_image_description_info_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, information.id)); err != nil {
+		object.client.FreeObjectId(information.id)
+		return WpImageDescriptionInfoV1{}, err
+	}
 
-	return information
+	return information, nil
 }
 
+// OnFailed registers a listener for the failed event
 func (object WpImageDescriptionV1) OnFailed(listener func(cause uint32, msg string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadString())
 	})
 }
 
+// OnReady registers a listener for the ready event
 func (object WpImageDescriptionV1) OnReady(listener func(identity uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpImageDescriptionInfoV1 is the wp_image_description_info_v1 interface
 type WpImageDescriptionInfoV1 Object
 
+// Interface returns the name of the interface, which is used by Bind
+func (WpImageDescriptionInfoV1) Interface() string {
+	return "wp_image_description_info_v1"
+}
+
+// OnDone registers a listener for the done event
 func (object WpImageDescriptionInfoV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnIccFile registers a listener for the icc_file event
 func (object WpImageDescriptionInfoV1) OnIccFile(listener func(icc int, iccSize uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadFd(), message.ReadUint32())
 	})
 }
 
+// OnPrimaries registers a listener for the primaries event
 func (object WpImageDescriptionInfoV1) OnPrimaries(listener func(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnPrimariesNamed registers a listener for the primaries_named event
 func (object WpImageDescriptionInfoV1) OnPrimariesNamed(listener func(primaries uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnTfPower registers a listener for the tf_power event
 func (object WpImageDescriptionInfoV1) OnTfPower(listener func(eexp uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnTfNamed registers a listener for the tf_named event
 func (object WpImageDescriptionInfoV1) OnTfNamed(listener func(tf uint32)) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnLuminances registers a listener for the luminances event
 func (object WpImageDescriptionInfoV1) OnLuminances(listener func(minLum uint32, maxLum uint32, referenceLum uint32)) chan struct{} {
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnTargetPrimaries registers a listener for the target_primaries event
 func (object WpImageDescriptionInfoV1) OnTargetPrimaries(listener func(rX int32, rY int32, gX int32, gY int32, bX int32, bY int32, wX int32, wY int32)) chan struct{} {
 	return object.client.On(object.id, 7, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnTargetLuminance registers a listener for the target_luminance event
 func (object WpImageDescriptionInfoV1) OnTargetLuminance(listener func(minLum uint32, maxLum uint32)) chan struct{} {
 	return object.client.On(object.id, 8, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnTargetMaxCll registers a listener for the target_max_cll event
 func (object WpImageDescriptionInfoV1) OnTargetMaxCll(listener func(maxCll uint32)) chan struct{} {
 	return object.client.On(object.id, 9, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnTargetMaxFall registers a listener for the target_max_fall event
 func (object WpImageDescriptionInfoV1) OnTargetMaxFall(listener func(maxFall uint32)) chan struct{} {
 	return object.client.On(object.id, 10, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpColorRepresentationManagerV1 is the wp_color_representation_manager_v1 interface
 type WpColorRepresentationManagerV1 Object
 
-func (object WpColorRepresentationManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorRepresentationManagerV1) Interface() string {
+	return "wp_color_representation_manager_v1"
 }
 
-func (object WpColorRepresentationManagerV1) GetSurface(surface WlSurface) WpColorRepresentationSurfaceV1 {
-	id := WpColorRepresentationSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_color_representation_surface_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorRepresentationManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetSurface sends the get_surface request
+func (object WpColorRepresentationManagerV1) GetSurface(surface WlSurface) (WpColorRepresentationSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_surface", 1); err != nil {
+		return WpColorRepresentationSurfaceV1{}, err
+	}
+
+	id := WpColorRepresentationSurfaceV1(object.client.newObject("wp_color_representation_surface_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpColorRepresentationSurfaceV1{}, err
+	}
+
+	return id, nil
 }
 
+// OnSupportedAlphaMode registers a listener for the supported_alpha_mode event
 func (object WpColorRepresentationManagerV1) OnSupportedAlphaMode(listener func(alphaMode uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnSupportedCoefficientsAndRanges registers a listener for the supported_coefficients_and_ranges event
 func (object WpColorRepresentationManagerV1) OnSupportedCoefficientsAndRanges(listener func(coefficients uint32, rnge uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object WpColorRepresentationManagerV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpColorRepresentationSurfaceV1 is the wp_color_representation_surface_v1 interface
 type WpColorRepresentationSurfaceV1 Object
 
-func (object WpColorRepresentationSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpColorRepresentationSurfaceV1) Interface() string {
+	return "wp_color_representation_surface_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpColorRepresentationSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpColorRepresentationSurfaceV1) SetAlphaMode(alphaMode uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, alphaMode))
+// SetAlphaMode sends the set_alpha_mode request
+func (object WpColorRepresentationSurfaceV1) SetAlphaMode(alphaMode uint32) error {
+	if err := Object(object).checkRequest("set_alpha_mode", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, alphaMode))
 }
 
-func (object WpColorRepresentationSurfaceV1) SetCoefficientsAndRange(coefficients uint32, rnge uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, coefficients, rnge))
+// SetCoefficientsAndRange sends the set_coefficients_and_range request
+func (object WpColorRepresentationSurfaceV1) SetCoefficientsAndRange(coefficients uint32, rnge uint32) error {
+	if err := Object(object).checkRequest("set_coefficients_and_range", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, coefficients, rnge))
 }
 
-func (object WpColorRepresentationSurfaceV1) SetChromaLocation(chromaLocation uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, chromaLocation))
+// SetChromaLocation sends the set_chroma_location request
+func (object WpColorRepresentationSurfaceV1) SetChromaLocation(chromaLocation uint32) error {
+	if err := Object(object).checkRequest("set_chroma_location", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, chromaLocation))
 }
 
+// WpCommitTimingManagerV1 is the wp_commit_timing_manager_v1 interface
 type WpCommitTimingManagerV1 Object
 
-func (object WpCommitTimingManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpCommitTimingManagerV1) Interface() string {
+	return "wp_commit_timing_manager_v1"
 }
 
-func (object WpCommitTimingManagerV1) GetTimer(surface WlSurface) WpCommitTimerV1 {
-	id := WpCommitTimerV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_commit_timer_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpCommitTimingManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+// GetTimer sends the get_timer request
+func (object WpCommitTimingManagerV1) GetTimer(surface WlSurface) (WpCommitTimerV1, error) {
+	if err := Object(object).checkRequest("get_timer", 1); err != nil {
+		return WpCommitTimerV1{}, err
+	}
+
+	id := WpCommitTimerV1(object.client.newObject("wp_commit_timer_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpCommitTimerV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WpCommitTimerV1 is the wp_commit_timer_v1 interface
 type WpCommitTimerV1 Object
 
-func (object WpCommitTimerV1) SetTimestamp(tvSecHi uint32, tvSecLo uint32, tvNsec uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, tvSecHi, tvSecLo, tvNsec))
+// Interface returns the name of the interface, which is used by Bind
+func (WpCommitTimerV1) Interface() string {
+	return "wp_commit_timer_v1"
 }
 
-func (object WpCommitTimerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// SetTimestamp sends the set_timestamp request
+func (object WpCommitTimerV1) SetTimestamp(tvSecHi uint32, tvSecLo uint32, tvNsec uint32) error {
+	if err := Object(object).checkRequest("set_timestamp", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, tvSecHi, tvSecLo, tvNsec))
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpCommitTimerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// WpContentTypeManagerV1 is the wp_content_type_manager_v1 interface
 type WpContentTypeManagerV1 Object
 
-func (object WpContentTypeManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpContentTypeManagerV1) Interface() string {
+	return "wp_content_type_manager_v1"
 }
 
-func (object WpContentTypeManagerV1) GetSurfaceContentType(surface WlSurface) WpContentTypeV1 {
-	id := WpContentTypeV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_content_type_v1",
-	})
+// WpContentTypeManagerV1Error is the error enum of wp_content_type_manager_v1
+type WpContentTypeManagerV1Error uint32
+
+const (
+	WpContentTypeManagerV1ErrorAlreadyConstructed WpContentTypeManagerV1Error = 0
+)
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+func (value WpContentTypeManagerV1Error) String() string {
+	switch value {
+	case WpContentTypeManagerV1ErrorAlreadyConstructed:
+		return "already_constructed"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpContentTypeManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetSurfaceContentType sends the get_surface_content_type request
+func (object WpContentTypeManagerV1) GetSurfaceContentType(surface WlSurface) (WpContentTypeV1, error) {
+	if err := Object(object).checkRequest("get_surface_content_type", 1); err != nil {
+		return WpContentTypeV1{}, err
+	}
 
-	return id
+	id := WpContentTypeV1(object.client.newObject("wp_content_type_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpContentTypeV1{}, err
+	}
+
+	return id, nil
 }
 
+// WpContentTypeV1 is the wp_content_type_v1 interface
 type WpContentTypeV1 Object
 
-func (object WpContentTypeV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpContentTypeV1) Interface() string {
+	return "wp_content_type_v1"
+}
+
+// WpContentTypeV1Type is the type enum of wp_content_type_v1
+type WpContentTypeV1Type uint32
+
+const (
+	WpContentTypeV1TypeNone WpContentTypeV1Type = 0
+	WpContentTypeV1TypePhoto WpContentTypeV1Type = 1
+	WpContentTypeV1TypeVideo WpContentTypeV1Type = 2
+	WpContentTypeV1TypeGame WpContentTypeV1Type = 3
+)
+
+func (value WpContentTypeV1Type) String() string {
+	switch value {
+	case WpContentTypeV1TypeNone:
+		return "none"
+	case WpContentTypeV1TypePhoto:
+		return "photo"
+	case WpContentTypeV1TypeVideo:
+		return "video"
+	case WpContentTypeV1TypeGame:
+		return "game"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpContentTypeV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpContentTypeV1) SetContentType(contentType uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, contentType))
+// SetContentType sends the set_content_type request
+func (object WpContentTypeV1) SetContentType(contentType WpContentTypeV1Type) error {
+	if err := Object(object).checkRequest("set_content_type", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, uint32(contentType)))
 }
 
+// WpCursorShapeManagerV1 is the wp_cursor_shape_manager_v1 interface
 type WpCursorShapeManagerV1 Object
 
-func (object WpCursorShapeManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpCursorShapeManagerV1) Interface() string {
+	return "wp_cursor_shape_manager_v1"
 }
 
-func (object WpCursorShapeManagerV1) GetPointer(pointer WlPointer) WpCursorShapeDeviceV1 {
-	cursorShapeDevice := WpCursorShapeDeviceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_cursor_shape_device_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpCursorShapeManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetPointer sends the get_pointer request
+func (object WpCursorShapeManagerV1) GetPointer(pointer WlPointer) (WpCursorShapeDeviceV1, error) {
+	if err := Object(object).checkRequest("get_pointer", 1); err != nil {
+		return WpCursorShapeDeviceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, cursorShapeDevice.id, pointer.id))
+	cursorShapeDevice := WpCursorShapeDeviceV1(object.client.newObject("wp_cursor_shape_device_v1", object.version))
 
-	return cursorShapeDevice
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, cursorShapeDevice.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(cursorShapeDevice.id)
+		return WpCursorShapeDeviceV1{}, err
+	}
+
+	return cursorShapeDevice, nil
 }
 
-func (object WpCursorShapeManagerV1) GetTabletToolV2(tabletTool ZwpTabletToolV2) WpCursorShapeDeviceV1 {
-	cursorShapeDevice := WpCursorShapeDeviceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_cursor_shape_device_v1",
-	})
+// GetTabletToolV2 sends the get_tablet_tool_v2 request
+func (object WpCursorShapeManagerV1) GetTabletToolV2(tabletTool ZwpTabletToolV2) (WpCursorShapeDeviceV1, error) {
+	if err := Object(object).checkRequest("get_tablet_tool_v2", 1); err != nil {
+		return WpCursorShapeDeviceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, cursorShapeDevice.id, tabletTool.id))
+	cursorShapeDevice := WpCursorShapeDeviceV1(object.client.newObject("wp_cursor_shape_device_v1", object.version))
 
-	return cursorShapeDevice
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, cursorShapeDevice.id, tabletTool.id)); err != nil {
+		object.client.FreeObjectId(cursorShapeDevice.id)
+		return WpCursorShapeDeviceV1{}, err
+	}
+
+	return cursorShapeDevice, nil
 }
 
+// WpCursorShapeDeviceV1 is the wp_cursor_shape_device_v1 interface
 type WpCursorShapeDeviceV1 Object
 
-func (object WpCursorShapeDeviceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpCursorShapeDeviceV1) Interface() string {
+	return "wp_cursor_shape_device_v1"
+}
+
+// WpCursorShapeDeviceV1Shape is the shape enum of wp_cursor_shape_device_v1
+type WpCursorShapeDeviceV1Shape uint32
+
+const (
+	WpCursorShapeDeviceV1ShapeDefault WpCursorShapeDeviceV1Shape = 1
+	WpCursorShapeDeviceV1ShapeContextMenu WpCursorShapeDeviceV1Shape = 2
+	WpCursorShapeDeviceV1ShapeHelp WpCursorShapeDeviceV1Shape = 3
+	WpCursorShapeDeviceV1ShapePointer WpCursorShapeDeviceV1Shape = 4
+	WpCursorShapeDeviceV1ShapeProgress WpCursorShapeDeviceV1Shape = 5
+	WpCursorShapeDeviceV1ShapeWait WpCursorShapeDeviceV1Shape = 6
+	WpCursorShapeDeviceV1ShapeCell WpCursorShapeDeviceV1Shape = 7
+	WpCursorShapeDeviceV1ShapeCrosshair WpCursorShapeDeviceV1Shape = 8
+	WpCursorShapeDeviceV1ShapeText WpCursorShapeDeviceV1Shape = 9
+	WpCursorShapeDeviceV1ShapeVerticalText WpCursorShapeDeviceV1Shape = 10
+	WpCursorShapeDeviceV1ShapeAlias WpCursorShapeDeviceV1Shape = 11
+	WpCursorShapeDeviceV1ShapeCopy WpCursorShapeDeviceV1Shape = 12
+	WpCursorShapeDeviceV1ShapeMove WpCursorShapeDeviceV1Shape = 13
+	WpCursorShapeDeviceV1ShapeNoDrop WpCursorShapeDeviceV1Shape = 14
+	WpCursorShapeDeviceV1ShapeNotAllowed WpCursorShapeDeviceV1Shape = 15
+	WpCursorShapeDeviceV1ShapeGrab WpCursorShapeDeviceV1Shape = 16
+	WpCursorShapeDeviceV1ShapeGrabbing WpCursorShapeDeviceV1Shape = 17
+	WpCursorShapeDeviceV1ShapeEResize WpCursorShapeDeviceV1Shape = 18
+	WpCursorShapeDeviceV1ShapeNResize WpCursorShapeDeviceV1Shape = 19
+	WpCursorShapeDeviceV1ShapeNeResize WpCursorShapeDeviceV1Shape = 20
+	WpCursorShapeDeviceV1ShapeNwResize WpCursorShapeDeviceV1Shape = 21
+	WpCursorShapeDeviceV1ShapeSResize WpCursorShapeDeviceV1Shape = 22
+	WpCursorShapeDeviceV1ShapeSeResize WpCursorShapeDeviceV1Shape = 23
+	WpCursorShapeDeviceV1ShapeSwResize WpCursorShapeDeviceV1Shape = 24
+	WpCursorShapeDeviceV1ShapeWResize WpCursorShapeDeviceV1Shape = 25
+	WpCursorShapeDeviceV1ShapeEwResize WpCursorShapeDeviceV1Shape = 26
+	WpCursorShapeDeviceV1ShapeNsResize WpCursorShapeDeviceV1Shape = 27
+	WpCursorShapeDeviceV1ShapeNeswResize WpCursorShapeDeviceV1Shape = 28
+	WpCursorShapeDeviceV1ShapeNwseResize WpCursorShapeDeviceV1Shape = 29
+	WpCursorShapeDeviceV1ShapeColResize WpCursorShapeDeviceV1Shape = 30
+	WpCursorShapeDeviceV1ShapeRowResize WpCursorShapeDeviceV1Shape = 31
+	WpCursorShapeDeviceV1ShapeAllScroll WpCursorShapeDeviceV1Shape = 32
+	WpCursorShapeDeviceV1ShapeZoomIn WpCursorShapeDeviceV1Shape = 33
+	WpCursorShapeDeviceV1ShapeZoomOut WpCursorShapeDeviceV1Shape = 34
+	// Available since version 2 of wp_cursor_shape_device_v1.
+	WpCursorShapeDeviceV1ShapeDndAsk WpCursorShapeDeviceV1Shape = 35
+	// Available since version 2 of wp_cursor_shape_device_v1.
+	WpCursorShapeDeviceV1ShapeAllResize WpCursorShapeDeviceV1Shape = 36
+)
+
+func (value WpCursorShapeDeviceV1Shape) String() string {
+	switch value {
+	case WpCursorShapeDeviceV1ShapeDefault:
+		return "default"
+	case WpCursorShapeDeviceV1ShapeContextMenu:
+		return "context_menu"
+	case WpCursorShapeDeviceV1ShapeHelp:
+		return "help"
+	case WpCursorShapeDeviceV1ShapePointer:
+		return "pointer"
+	case WpCursorShapeDeviceV1ShapeProgress:
+		return "progress"
+	case WpCursorShapeDeviceV1ShapeWait:
+		return "wait"
+	case WpCursorShapeDeviceV1ShapeCell:
+		return "cell"
+	case WpCursorShapeDeviceV1ShapeCrosshair:
+		return "crosshair"
+	case WpCursorShapeDeviceV1ShapeText:
+		return "text"
+	case WpCursorShapeDeviceV1ShapeVerticalText:
+		return "vertical_text"
+	case WpCursorShapeDeviceV1ShapeAlias:
+		return "alias"
+	case WpCursorShapeDeviceV1ShapeCopy:
+		return "copy"
+	case WpCursorShapeDeviceV1ShapeMove:
+		return "move"
+	case WpCursorShapeDeviceV1ShapeNoDrop:
+		return "no_drop"
+	case WpCursorShapeDeviceV1ShapeNotAllowed:
+		return "not_allowed"
+	case WpCursorShapeDeviceV1ShapeGrab:
+		return "grab"
+	case WpCursorShapeDeviceV1ShapeGrabbing:
+		return "grabbing"
+	case WpCursorShapeDeviceV1ShapeEResize:
+		return "e_resize"
+	case WpCursorShapeDeviceV1ShapeNResize:
+		return "n_resize"
+	case WpCursorShapeDeviceV1ShapeNeResize:
+		return "ne_resize"
+	case WpCursorShapeDeviceV1ShapeNwResize:
+		return "nw_resize"
+	case WpCursorShapeDeviceV1ShapeSResize:
+		return "s_resize"
+	case WpCursorShapeDeviceV1ShapeSeResize:
+		return "se_resize"
+	case WpCursorShapeDeviceV1ShapeSwResize:
+		return "sw_resize"
+	case WpCursorShapeDeviceV1ShapeWResize:
+		return "w_resize"
+	case WpCursorShapeDeviceV1ShapeEwResize:
+		return "ew_resize"
+	case WpCursorShapeDeviceV1ShapeNsResize:
+		return "ns_resize"
+	case WpCursorShapeDeviceV1ShapeNeswResize:
+		return "nesw_resize"
+	case WpCursorShapeDeviceV1ShapeNwseResize:
+		return "nwse_resize"
+	case WpCursorShapeDeviceV1ShapeColResize:
+		return "col_resize"
+	case WpCursorShapeDeviceV1ShapeRowResize:
+		return "row_resize"
+	case WpCursorShapeDeviceV1ShapeAllScroll:
+		return "all_scroll"
+	case WpCursorShapeDeviceV1ShapeZoomIn:
+		return "zoom_in"
+	case WpCursorShapeDeviceV1ShapeZoomOut:
+		return "zoom_out"
+	case WpCursorShapeDeviceV1ShapeDndAsk:
+		return "dnd_ask"
+	case WpCursorShapeDeviceV1ShapeAllResize:
+		return "all_resize"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of wp_cursor_shape_device_v1 value was introduced in
+func (value WpCursorShapeDeviceV1Shape) since() uint32 {
+	switch value {
+	case WpCursorShapeDeviceV1ShapeDndAsk:
+		return 2
+	case WpCursorShapeDeviceV1ShapeAllResize:
+		return 2
+	}
+	return 1
+}
+
+// WpCursorShapeDeviceV1Error is the error enum of wp_cursor_shape_device_v1
+type WpCursorShapeDeviceV1Error uint32
+
+const (
+	WpCursorShapeDeviceV1ErrorInvalidShape WpCursorShapeDeviceV1Error = 1
+)
+
+func (value WpCursorShapeDeviceV1Error) String() string {
+	switch value {
+	case WpCursorShapeDeviceV1ErrorInvalidShape:
+		return "invalid_shape"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpCursorShapeDeviceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpCursorShapeDeviceV1) SetShape(serial uint32, shape uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, serial, shape))
+// SetShape sends the set_shape request
+func (object WpCursorShapeDeviceV1) SetShape(serial uint32, shape WpCursorShapeDeviceV1Shape) error {
+	if err := Object(object).checkRequest("set_shape", 1); err != nil {
+		return err
+	}
+	if since := shape.since(); since > 1 {
+		if err := Object(object).checkVersion("set_shape with shape " + shape.String(), since); err != nil {
+			return err
+		}
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, serial, uint32(shape)))
 }
 
+// WpDrmLeaseDeviceV1 is the wp_drm_lease_device_v1 interface
 type WpDrmLeaseDeviceV1 Object
 
-func (object WpDrmLeaseDeviceV1) CreateLeaseRequest() WpDrmLeaseRequestV1 {
-	id := WpDrmLeaseRequestV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_drm_lease_request_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (WpDrmLeaseDeviceV1) Interface() string {
+	return "wp_drm_lease_device_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id))
+// CreateLeaseRequest sends the create_lease_request request
+func (object WpDrmLeaseDeviceV1) CreateLeaseRequest() (WpDrmLeaseRequestV1, error) {
+	if err := Object(object).checkRequest("create_lease_request", 1); err != nil {
+		return WpDrmLeaseRequestV1{}, err
+	}
+
+	id := WpDrmLeaseRequestV1(object.client.newObject("wp_drm_lease_request_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpDrmLeaseRequestV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object WpDrmLeaseDeviceV1) Release() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Release sends the release request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpDrmLeaseDeviceV1) Release() error {
+	if err := Object(object).checkRequest("release", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnDrmFd registers a listener for the drm_fd event
 func (object WpDrmLeaseDeviceV1) OnDrmFd(listener func(fd int)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadFd())
 	})
 }
 
+// OnConnector registers a listener for the connector event
 func (object WpDrmLeaseDeviceV1) OnConnector(listener func(id WpDrmLeaseConnectorV1)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(WpDrmLeaseConnectorV1(Object{client: object.client, id: object.client.NewObjectId(), iface: "wp_drm_lease_connector_v1"}))
+		listener(WpDrmLeaseConnectorV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object WpDrmLeaseDeviceV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnReleased registers a listener for the released event
 func (object WpDrmLeaseDeviceV1) OnReleased(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpDrmLeaseConnectorV1 is the wp_drm_lease_connector_v1 interface
 type WpDrmLeaseConnectorV1 Object
 
-func (object WpDrmLeaseConnectorV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpDrmLeaseConnectorV1) Interface() string {
+	return "wp_drm_lease_connector_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpDrmLeaseConnectorV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnName registers a listener for the name event
 func (object WpDrmLeaseConnectorV1) OnName(listener func(name string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnDescription registers a listener for the description event
 func (object WpDrmLeaseConnectorV1) OnDescription(listener func(description string)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnConnectorId registers a listener for the connector_id event
 func (object WpDrmLeaseConnectorV1) OnConnectorId(listener func(connectorId uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object WpDrmLeaseConnectorV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnWithdrawn registers a listener for the withdrawn event
 func (object WpDrmLeaseConnectorV1) OnWithdrawn(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpDrmLeaseRequestV1 is the wp_drm_lease_request_v1 interface
 type WpDrmLeaseRequestV1 Object
 
-func (object WpDrmLeaseRequestV1) RequestConnector(connector WpDrmLeaseConnectorV1) {
-	object.client.Write(wayland.NewMessage(object.id, 0, connector.id))
+// Interface returns the name of the interface, which is used by Bind
+func (WpDrmLeaseRequestV1) Interface() string {
+	return "wp_drm_lease_request_v1"
 }
 
-func (object WpDrmLeaseRequestV1) Submit() WpDrmLeaseV1 {
-	id := WpDrmLeaseV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_drm_lease_v1",
-	})
+// RequestConnector sends the request_connector request
+func (object WpDrmLeaseRequestV1) RequestConnector(connector WpDrmLeaseConnectorV1) error {
+	if err := Object(object).checkRequest("request_connector", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, connector.id))
+}
+
+// Submit sends the submit request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpDrmLeaseRequestV1) Submit() (WpDrmLeaseV1, error) {
+	if err := Object(object).checkRequest("submit", 1); err != nil {
+		return WpDrmLeaseV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	id := WpDrmLeaseV1(object.client.newObject("wp_drm_lease_v1", object.version))
 
-	return id
+	if err := object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpDrmLeaseV1{}, err
+	}
+
+	return id, nil
 }
 
+// WpDrmLeaseV1 is the wp_drm_lease_v1 interface
 type WpDrmLeaseV1 Object
 
-func (object WpDrmLeaseV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpDrmLeaseV1) Interface() string {
+	return "wp_drm_lease_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpDrmLeaseV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnLeaseFd registers a listener for the lease_fd event
 func (object WpDrmLeaseV1) OnLeaseFd(listener func(leasedFd int)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadFd())
 	})
 }
 
+// OnFinished registers a listener for the finished event
 func (object WpDrmLeaseV1) OnFinished(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtBackgroundEffectManagerV1 is the ext_background_effect_manager_v1 interface
 type ExtBackgroundEffectManagerV1 Object
 
-func (object ExtBackgroundEffectManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtBackgroundEffectManagerV1) Interface() string {
+	return "ext_background_effect_manager_v1"
 }
 
-func (object ExtBackgroundEffectManagerV1) GetBackgroundEffect(surface WlSurface) ExtBackgroundEffectSurfaceV1 {
-	id := ExtBackgroundEffectSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_background_effect_surface_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtBackgroundEffectManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetBackgroundEffect sends the get_background_effect request
+func (object ExtBackgroundEffectManagerV1) GetBackgroundEffect(surface WlSurface) (ExtBackgroundEffectSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_background_effect", 1); err != nil {
+		return ExtBackgroundEffectSurfaceV1{}, err
+	}
+
+	id := ExtBackgroundEffectSurfaceV1(object.client.newObject("ext_background_effect_surface_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtBackgroundEffectSurfaceV1{}, err
+	}
+
+	return id, nil
 }
 
+// OnCapabilities registers a listener for the capabilities event
 func (object ExtBackgroundEffectManagerV1) OnCapabilities(listener func(flags uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ExtBackgroundEffectSurfaceV1 is the ext_background_effect_surface_v1 interface
 type ExtBackgroundEffectSurfaceV1 Object
 
-func (object ExtBackgroundEffectSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtBackgroundEffectSurfaceV1) Interface() string {
+	return "ext_background_effect_surface_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtBackgroundEffectSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object ExtBackgroundEffectSurfaceV1) SetBlurRegion(region WlRegion) {
-	object.client.Write(wayland.NewMessage(object.id, 1, region.id))
+// SetBlurRegion sends the set_blur_region request
+func (object ExtBackgroundEffectSurfaceV1) SetBlurRegion(region WlRegion) error {
+	if err := Object(object).checkRequest("set_blur_region", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, region.id))
 }
 
+// ExtDataControlManagerV1 is the ext_data_control_manager_v1 interface
 type ExtDataControlManagerV1 Object
 
-func (object ExtDataControlManagerV1) CreateDataSource() ExtDataControlSourceV1 {
-	id := ExtDataControlSourceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_data_control_source_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtDataControlManagerV1) Interface() string {
+	return "ext_data_control_manager_v1"
+}
+
+// CreateDataSource sends the create_data_source request
+func (object ExtDataControlManagerV1) CreateDataSource() (ExtDataControlSourceV1, error) {
+	if err := Object(object).checkRequest("create_data_source", 1); err != nil {
+		return ExtDataControlSourceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, id.id))
+	id := ExtDataControlSourceV1(object.client.newObject("ext_data_control_source_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtDataControlSourceV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object ExtDataControlManagerV1) GetDataDevice(seat WlSeat) ExtDataControlDeviceV1 {
-	id := ExtDataControlDeviceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_data_control_device_v1",
-	})
+// GetDataDevice sends the get_data_device request
+func (object ExtDataControlManagerV1) GetDataDevice(seat WlSeat) (ExtDataControlDeviceV1, error) {
+	if err := Object(object).checkRequest("get_data_device", 1); err != nil {
+		return ExtDataControlDeviceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id))
+	id := ExtDataControlDeviceV1(object.client.newObject("ext_data_control_device_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtDataControlDeviceV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object ExtDataControlManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtDataControlManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
+// ExtDataControlDeviceV1 is the ext_data_control_device_v1 interface
 type ExtDataControlDeviceV1 Object
 
-func (object ExtDataControlDeviceV1) SetSelection(source ExtDataControlSourceV1) {
-	object.client.Write(wayland.NewMessage(object.id, 0, source.id))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtDataControlDeviceV1) Interface() string {
+	return "ext_data_control_device_v1"
 }
 
-func (object ExtDataControlDeviceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// SetSelection sends the set_selection request
+//
+// Arguments:
+//   - source: nil for null
+func (object ExtDataControlDeviceV1) SetSelection(source *ExtDataControlSourceV1) error {
+	if err := Object(object).checkRequest("set_selection", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, nullableId(source)))
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtDataControlDeviceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
-func (object ExtDataControlDeviceV1) SetPrimarySelection(source ExtDataControlSourceV1) {
-	object.client.Write(wayland.NewMessage(object.id, 2, source.id))
+// SetPrimarySelection sends the set_primary_selection request
+//
+// Arguments:
+//   - source: nil for null
+func (object ExtDataControlDeviceV1) SetPrimarySelection(source *ExtDataControlSourceV1) error {
+	if err := Object(object).checkRequest("set_primary_selection", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, nullableId(source)))
 }
 
+// OnDataOffer registers a listener for the data_offer event
 func (object ExtDataControlDeviceV1) OnDataOffer(listener func(id ExtDataControlOfferV1)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(ExtDataControlOfferV1(Object{client: object.client, id: object.client.NewObjectId(), iface: "ext_data_control_offer_v1"}))
+		listener(ExtDataControlOfferV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
-func (object ExtDataControlDeviceV1) OnSelection(listener func(id ExtDataControlOfferV1)) chan struct{} {
+// OnSelection registers a listener for the selection event
+//
+// Arguments:
+//   - id: nil for null
+func (object ExtDataControlDeviceV1) OnSelection(listener func(id *ExtDataControlOfferV1)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(ExtDataControlOfferV1(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(resolveNullable[ExtDataControlOfferV1](object.client, message.ReadUint32()))
 	})
 }
 
+// OnFinished registers a listener for the finished event
 func (object ExtDataControlDeviceV1) OnFinished(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
-func (object ExtDataControlDeviceV1) OnPrimarySelection(listener func(id ExtDataControlOfferV1)) chan struct{} {
+// OnPrimarySelection registers a listener for the primary_selection event
+//
+// Arguments:
+//   - id: nil for null
+func (object ExtDataControlDeviceV1) OnPrimarySelection(listener func(id *ExtDataControlOfferV1)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
-		listener(ExtDataControlOfferV1(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(resolveNullable[ExtDataControlOfferV1](object.client, message.ReadUint32()))
 	})
 }
 
+// ExtDataControlSourceV1 is the ext_data_control_source_v1 interface
 type ExtDataControlSourceV1 Object
 
-func (object ExtDataControlSourceV1) Offer(mimeType string) {
-	object.client.Write(wayland.NewMessage(object.id, 0, mimeType))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtDataControlSourceV1) Interface() string {
+	return "ext_data_control_source_v1"
 }
 
-func (object ExtDataControlSourceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Offer sends the offer request
+func (object ExtDataControlSourceV1) Offer(mimeType string) error {
+	if err := Object(object).checkRequest("offer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, mimeType))
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtDataControlSourceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnSend registers a listener for the send event
 func (object ExtDataControlSourceV1) OnSend(listener func(mimeType string, fd int)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString(), message.ReadFd())
 	})
 }
 
+// OnCancelled registers a listener for the cancelled event
 func (object ExtDataControlSourceV1) OnCancelled(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtDataControlOfferV1 is the ext_data_control_offer_v1 interface
 type ExtDataControlOfferV1 Object
 
-func (object ExtDataControlOfferV1) Receive(mimeType string, fd int) {
-	object.client.Write(wayland.NewMessage(object.id, 0, mimeType).WithFds(fd))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtDataControlOfferV1) Interface() string {
+	return "ext_data_control_offer_v1"
 }
 
-func (object ExtDataControlOfferV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Receive sends the receive request
+func (object ExtDataControlOfferV1) Receive(mimeType string, fd int) error {
+	if err := Object(object).checkRequest("receive", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, mimeType).WithFds(fd))
 }
 
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtDataControlOfferV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// OnOffer registers a listener for the offer event
 func (object ExtDataControlOfferV1) OnOffer(listener func(mimeType string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// ExtForeignToplevelListV1 is the ext_foreign_toplevel_list_v1 interface
 type ExtForeignToplevelListV1 Object
 
-func (object ExtForeignToplevelListV1) Stop() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtForeignToplevelListV1) Interface() string {
+	return "ext_foreign_toplevel_list_v1"
+}
+
+// Stop sends the stop request
+func (object ExtForeignToplevelListV1) Stop() error {
+	if err := Object(object).checkRequest("stop", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0))
 }
 
-func (object ExtForeignToplevelListV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtForeignToplevelListV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnToplevel registers a listener for the toplevel event
 func (object ExtForeignToplevelListV1) OnToplevel(listener func(toplevel ExtForeignToplevelHandleV1)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(ExtForeignToplevelHandleV1(Object{client: object.client, id: object.client.NewObjectId(), iface: "ext_foreign_toplevel_handle_v1"}))
+		listener(ExtForeignToplevelHandleV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnFinished registers a listener for the finished event
 func (object ExtForeignToplevelListV1) OnFinished(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtForeignToplevelHandleV1 is the ext_foreign_toplevel_handle_v1 interface
 type ExtForeignToplevelHandleV1 Object
 
-func (object ExtForeignToplevelHandleV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtForeignToplevelHandleV1) Interface() string {
+	return "ext_foreign_toplevel_handle_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtForeignToplevelHandleV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnClosed registers a listener for the closed event
 func (object ExtForeignToplevelHandleV1) OnClosed(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ExtForeignToplevelHandleV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnTitle registers a listener for the title event
 func (object ExtForeignToplevelHandleV1) OnTitle(listener func(title string)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnAppId registers a listener for the app_id event
 func (object ExtForeignToplevelHandleV1) OnAppId(listener func(appId string)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnIdentifier registers a listener for the identifier event
 func (object ExtForeignToplevelHandleV1) OnIdentifier(listener func(identifier string)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// ExtIdleNotifierV1 is the ext_idle_notifier_v1 interface
 type ExtIdleNotifierV1 Object
 
-func (object ExtIdleNotifierV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtIdleNotifierV1) Interface() string {
+	return "ext_idle_notifier_v1"
 }
 
-func (object ExtIdleNotifierV1) GetIdleNotification(timeout uint32, seat WlSeat) ExtIdleNotificationV1 {
-	id := ExtIdleNotificationV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_idle_notification_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtIdleNotifierV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, timeout, seat.id))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetIdleNotification sends the get_idle_notification request
+func (object ExtIdleNotifierV1) GetIdleNotification(timeout uint32, seat WlSeat) (ExtIdleNotificationV1, error) {
+	if err := Object(object).checkRequest("get_idle_notification", 1); err != nil {
+		return ExtIdleNotificationV1{}, err
+	}
+
+	id := ExtIdleNotificationV1(object.client.newObject("ext_idle_notification_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, timeout, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtIdleNotificationV1{}, err
+	}
+
+	return id, nil
 }
 
-func (object ExtIdleNotifierV1) GetInputIdleNotification(timeout uint32, seat WlSeat) ExtIdleNotificationV1 {
-	id := ExtIdleNotificationV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_idle_notification_v1",
-	})
+// GetInputIdleNotification sends the get_input_idle_notification request
+//
+// Available since version 2 of ext_idle_notifier_v1.
+func (object ExtIdleNotifierV1) GetInputIdleNotification(timeout uint32, seat WlSeat) (ExtIdleNotificationV1, error) {
+	if err := Object(object).checkRequest("get_input_idle_notification", 2); err != nil {
+		return ExtIdleNotificationV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id, timeout, seat.id))
+	id := ExtIdleNotificationV1(object.client.newObject("ext_idle_notification_v1", object.version))
 
-	return id
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, timeout, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtIdleNotificationV1{}, err
+	}
+
+	return id, nil
 }
 
+// ExtIdleNotificationV1 is the ext_idle_notification_v1 interface
 type ExtIdleNotificationV1 Object
 
-func (object ExtIdleNotificationV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtIdleNotificationV1) Interface() string {
+	return "ext_idle_notification_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtIdleNotificationV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnIdled registers a listener for the idled event
 func (object ExtIdleNotificationV1) OnIdled(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnResumed registers a listener for the resumed event
 func (object ExtIdleNotificationV1) OnResumed(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtImageCaptureSourceV1 is the ext_image_capture_source_v1 interface
 type ExtImageCaptureSourceV1 Object
 
-func (object ExtImageCaptureSourceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtImageCaptureSourceV1) Interface() string {
+	return "ext_image_capture_source_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtImageCaptureSourceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// ExtOutputImageCaptureSourceManagerV1 is the ext_output_image_capture_source_manager_v1 interface
 type ExtOutputImageCaptureSourceManagerV1 Object
 
-func (object ExtOutputImageCaptureSourceManagerV1) CreateSource(output WlOutput) ExtImageCaptureSourceV1 {
-	source := ExtImageCaptureSourceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_capture_source_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtOutputImageCaptureSourceManagerV1) Interface() string {
+	return "ext_output_image_capture_source_manager_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, source.id, output.id))
+// CreateSource sends the create_source request
+func (object ExtOutputImageCaptureSourceManagerV1) CreateSource(output WlOutput) (ExtImageCaptureSourceV1, error) {
+	if err := Object(object).checkRequest("create_source", 1); err != nil {
+		return ExtImageCaptureSourceV1{}, err
+	}
+
+	source := ExtImageCaptureSourceV1(object.client.newObject("ext_image_capture_source_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, source.id, output.id)); err != nil {
+		object.client.FreeObjectId(source.id)
+		return ExtImageCaptureSourceV1{}, err
+	}
 
-	return source
+	return source, nil
 }
 
-func (object ExtOutputImageCaptureSourceManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtOutputImageCaptureSourceManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// ExtForeignToplevelImageCaptureSourceManagerV1 is the ext_foreign_toplevel_image_capture_source_manager_v1 interface
 type ExtForeignToplevelImageCaptureSourceManagerV1 Object
 
-func (object ExtForeignToplevelImageCaptureSourceManagerV1) CreateSource(toplevelHandle ExtForeignToplevelHandleV1) ExtImageCaptureSourceV1 {
-	source := ExtImageCaptureSourceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_capture_source_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtForeignToplevelImageCaptureSourceManagerV1) Interface() string {
+	return "ext_foreign_toplevel_image_capture_source_manager_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, source.id, toplevelHandle.id))
+// CreateSource sends the create_source request
+func (object ExtForeignToplevelImageCaptureSourceManagerV1) CreateSource(toplevelHandle ExtForeignToplevelHandleV1) (ExtImageCaptureSourceV1, error) {
+	if err := Object(object).checkRequest("create_source", 1); err != nil {
+		return ExtImageCaptureSourceV1{}, err
+	}
+
+	source := ExtImageCaptureSourceV1(object.client.newObject("ext_image_capture_source_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, source.id, toplevelHandle.id)); err != nil {
+		object.client.FreeObjectId(source.id)
+		return ExtImageCaptureSourceV1{}, err
+	}
 
-	return source
+	return source, nil
 }
 
-func (object ExtForeignToplevelImageCaptureSourceManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtForeignToplevelImageCaptureSourceManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// ExtImageCopyCaptureManagerV1 is the ext_image_copy_capture_manager_v1 interface
 type ExtImageCopyCaptureManagerV1 Object
 
-func (object ExtImageCopyCaptureManagerV1) CreateSession(source ExtImageCaptureSourceV1, options uint32) ExtImageCopyCaptureSessionV1 {
-	session := ExtImageCopyCaptureSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_copy_capture_session_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtImageCopyCaptureManagerV1) Interface() string {
+	return "ext_image_copy_capture_manager_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, session.id, source.id, options))
+// CreateSession sends the create_session request
+func (object ExtImageCopyCaptureManagerV1) CreateSession(source ExtImageCaptureSourceV1, options uint32) (ExtImageCopyCaptureSessionV1, error) {
+	if err := Object(object).checkRequest("create_session", 1); err != nil {
+		return ExtImageCopyCaptureSessionV1{}, err
+	}
+
+	session := ExtImageCopyCaptureSessionV1(object.client.newObject("ext_image_copy_capture_session_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, session.id, source.id, options)); err != nil {
+		object.client.FreeObjectId(session.id)
+		return ExtImageCopyCaptureSessionV1{}, err
+	}
 
-	return session
+	return session, nil
 }
 
-func (object ExtImageCopyCaptureManagerV1) CreatePointerCursorSession(source ExtImageCaptureSourceV1, pointer WlPointer) ExtImageCopyCaptureCursorSessionV1 {
-	session := ExtImageCopyCaptureCursorSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_copy_capture_cursor_session_v1",
-	})
+// CreatePointerCursorSession sends the create_pointer_cursor_session request
+func (object ExtImageCopyCaptureManagerV1) CreatePointerCursorSession(source ExtImageCaptureSourceV1, pointer WlPointer) (ExtImageCopyCaptureCursorSessionV1, error) {
+	if err := Object(object).checkRequest("create_pointer_cursor_session", 1); err != nil {
+		return ExtImageCopyCaptureCursorSessionV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, session.id, source.id, pointer.id))
+	session := ExtImageCopyCaptureCursorSessionV1(object.client.newObject("ext_image_copy_capture_cursor_session_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, session.id, source.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(session.id)
+		return ExtImageCopyCaptureCursorSessionV1{}, err
+	}
 
-	return session
+	return session, nil
 }
 
-func (object ExtImageCopyCaptureManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtImageCopyCaptureManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
+// ExtImageCopyCaptureSessionV1 is the ext_image_copy_capture_session_v1 interface
 type ExtImageCopyCaptureSessionV1 Object
 
-func (object ExtImageCopyCaptureSessionV1) CreateFrame() ExtImageCopyCaptureFrameV1 {
-	frame := ExtImageCopyCaptureFrameV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_copy_capture_frame_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtImageCopyCaptureSessionV1) Interface() string {
+	return "ext_image_copy_capture_session_v1"
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, frame.id))
+// CreateFrame sends the create_frame request
+func (object ExtImageCopyCaptureSessionV1) CreateFrame() (ExtImageCopyCaptureFrameV1, error) {
+	if err := Object(object).checkRequest("create_frame", 1); err != nil {
+		return ExtImageCopyCaptureFrameV1{}, err
+	}
+
+	frame := ExtImageCopyCaptureFrameV1(object.client.newObject("ext_image_copy_capture_frame_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, frame.id)); err != nil {
+		object.client.FreeObjectId(frame.id)
+		return ExtImageCopyCaptureFrameV1{}, err
+	}
 
-	return frame
+	return frame, nil
 }
 
-func (object ExtImageCopyCaptureSessionV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtImageCopyCaptureSessionV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnBufferSize registers a listener for the buffer_size event
 func (object ExtImageCopyCaptureSessionV1) OnBufferSize(listener func(width uint32, height uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnShmFormat registers a listener for the shm_format event
 func (object ExtImageCopyCaptureSessionV1) OnShmFormat(listener func(format uint32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
-func (object ExtImageCopyCaptureSessionV1) OnDmabufDevice(listener func(device []uint32)) chan struct{} {
+// OnDmabufDevice registers a listener for the dmabuf_device event
+func (object ExtImageCopyCaptureSessionV1) OnDmabufDevice(listener func(device wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
-func (object ExtImageCopyCaptureSessionV1) OnDmabufFormat(listener func(format uint32, modifiers []uint32)) chan struct{} {
+// OnDmabufFormat registers a listener for the dmabuf_format event
+func (object ExtImageCopyCaptureSessionV1) OnDmabufFormat(listener func(format uint32, modifiers wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadArray())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ExtImageCopyCaptureSessionV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnStopped registers a listener for the stopped event
 func (object ExtImageCopyCaptureSessionV1) OnStopped(listener func()) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtImageCopyCaptureFrameV1 is the ext_image_copy_capture_frame_v1 interface
 type ExtImageCopyCaptureFrameV1 Object
 
-func (object ExtImageCopyCaptureFrameV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtImageCopyCaptureFrameV1) Interface() string {
+	return "ext_image_copy_capture_frame_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtImageCopyCaptureFrameV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object ExtImageCopyCaptureFrameV1) AttachBuffer(buffer WlBuffer) {
-	object.client.Write(wayland.NewMessage(object.id, 1, buffer.id))
+// AttachBuffer sends the attach_buffer request
+func (object ExtImageCopyCaptureFrameV1) AttachBuffer(buffer WlBuffer) error {
+	if err := Object(object).checkRequest("attach_buffer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, buffer.id))
 }
 
-func (object ExtImageCopyCaptureFrameV1) DamageBuffer(x int32, y int32, width int32, height int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
+// DamageBuffer sends the damage_buffer request
+func (object ExtImageCopyCaptureFrameV1) DamageBuffer(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("damage_buffer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, x, y, width, height))
 }
 
-func (object ExtImageCopyCaptureFrameV1) Capture() {
-	object.client.Write(wayland.NewMessage(object.id, 3))
+// Capture sends the capture request
+func (object ExtImageCopyCaptureFrameV1) Capture() error {
+	if err := Object(object).checkRequest("capture", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3))
 }
 
+// OnTransform registers a listener for the transform event
 func (object ExtImageCopyCaptureFrameV1) OnTransform(listener func(transform uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDamage registers a listener for the damage event
 func (object ExtImageCopyCaptureFrameV1) OnDamage(listener func(x int32, y int32, width int32, height int32)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32(), message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnPresentationTime registers a listener for the presentation_time event
 func (object ExtImageCopyCaptureFrameV1) OnPresentationTime(listener func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnReady registers a listener for the ready event
 func (object ExtImageCopyCaptureFrameV1) OnReady(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFailed registers a listener for the failed event
 func (object ExtImageCopyCaptureFrameV1) OnFailed(listener func(reason uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// ExtImageCopyCaptureCursorSessionV1 is the ext_image_copy_capture_cursor_session_v1 interface
 type ExtImageCopyCaptureCursorSessionV1 Object
 
-func (object ExtImageCopyCaptureCursorSessionV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtImageCopyCaptureCursorSessionV1) Interface() string {
+	return "ext_image_copy_capture_cursor_session_v1"
 }
 
-func (object ExtImageCopyCaptureCursorSessionV1) GetCaptureSession() ExtImageCopyCaptureSessionV1 {
-	session := ExtImageCopyCaptureSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_image_copy_capture_session_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtImageCopyCaptureCursorSessionV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, session.id))
+// GetCaptureSession sends the get_capture_session request
+func (object ExtImageCopyCaptureCursorSessionV1) GetCaptureSession() (ExtImageCopyCaptureSessionV1, error) {
+	if err := Object(object).checkRequest("get_capture_session", 1); err != nil {
+		return ExtImageCopyCaptureSessionV1{}, err
+	}
+
+	session := ExtImageCopyCaptureSessionV1(object.client.newObject("ext_image_copy_capture_session_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, session.id)); err != nil {
+		object.client.FreeObjectId(session.id)
+		return ExtImageCopyCaptureSessionV1{}, err
+	}
 
-	return session
+	return session, nil
 }
 
+// OnEnter registers a listener for the enter event
 func (object ExtImageCopyCaptureCursorSessionV1) OnEnter(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnLeave registers a listener for the leave event
 func (object ExtImageCopyCaptureCursorSessionV1) OnLeave(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnPosition registers a listener for the position event
 func (object ExtImageCopyCaptureCursorSessionV1) OnPosition(listener func(x int32, y int32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// OnHotspot registers a listener for the hotspot event
 func (object ExtImageCopyCaptureCursorSessionV1) OnHotspot(listener func(x int32, y int32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadInt32(), message.ReadInt32())
 	})
 }
 
+// ExtSessionLockManagerV1 is the ext_session_lock_manager_v1 interface
 type ExtSessionLockManagerV1 Object
 
-func (object ExtSessionLockManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtSessionLockManagerV1) Interface() string {
+	return "ext_session_lock_manager_v1"
 }
 
-func (object ExtSessionLockManagerV1) Lock() ExtSessionLockV1 {
-	id := ExtSessionLockV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_session_lock_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtSessionLockManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+// Lock sends the lock request
+func (object ExtSessionLockManagerV1) Lock() (ExtSessionLockV1, error) {
+	if err := Object(object).checkRequest("lock", 1); err != nil {
+		return ExtSessionLockV1{}, err
+	}
+
+	id := ExtSessionLockV1(object.client.newObject("ext_session_lock_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtSessionLockV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// ExtSessionLockV1 is the ext_session_lock_v1 interface
 type ExtSessionLockV1 Object
 
-func (object ExtSessionLockV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtSessionLockV1) Interface() string {
+	return "ext_session_lock_v1"
 }
 
-func (object ExtSessionLockV1) GetLockSurface(surface WlSurface, output WlOutput) ExtSessionLockSurfaceV1 {
-	id := ExtSessionLockSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_session_lock_surface_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtSessionLockV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, output.id))
+// GetLockSurface sends the get_lock_surface request
+func (object ExtSessionLockV1) GetLockSurface(surface WlSurface, output WlOutput) (ExtSessionLockSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_lock_surface", 1); err != nil {
+		return ExtSessionLockSurfaceV1{}, err
+	}
+
+	id := ExtSessionLockSurfaceV1(object.client.newObject("ext_session_lock_surface_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, output.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ExtSessionLockSurfaceV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object ExtSessionLockV1) UnlockAndDestroy() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// UnlockAndDestroy sends the unlock_and_destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtSessionLockV1) UnlockAndDestroy() error {
+	if err := Object(object).checkRequest("unlock_and_destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
+// OnLocked registers a listener for the locked event
 func (object ExtSessionLockV1) OnLocked(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFinished registers a listener for the finished event
 func (object ExtSessionLockV1) OnFinished(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtSessionLockSurfaceV1 is the ext_session_lock_surface_v1 interface
 type ExtSessionLockSurfaceV1 Object
 
-func (object ExtSessionLockSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtSessionLockSurfaceV1) Interface() string {
+	return "ext_session_lock_surface_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtSessionLockSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object ExtSessionLockSurfaceV1) AckConfigure(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, serial))
+// AckConfigure sends the ack_configure request
+func (object ExtSessionLockSurfaceV1) AckConfigure(serial uint32) error {
+	if err := Object(object).checkRequest("ack_configure", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, serial))
 }
 
+// OnConfigure registers a listener for the configure event
 func (object ExtSessionLockSurfaceV1) OnConfigure(listener func(serial uint32, width uint32, height uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// ExtTransientSeatManagerV1 is the ext_transient_seat_manager_v1 interface
 type ExtTransientSeatManagerV1 Object
 
-func (object ExtTransientSeatManagerV1) Create() ExtTransientSeatV1 {
-	seat := ExtTransientSeatV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "ext_transient_seat_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (ExtTransientSeatManagerV1) Interface() string {
+	return "ext_transient_seat_manager_v1"
+}
+
+// Create sends the create request
+func (object ExtTransientSeatManagerV1) Create() (ExtTransientSeatV1, error) {
+	if err := Object(object).checkRequest("create", 1); err != nil {
+		return ExtTransientSeatV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 0, seat.id))
+	seat := ExtTransientSeatV1(object.client.newObject("ext_transient_seat_v1", object.version))
 
-	return seat
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, seat.id)); err != nil {
+		object.client.FreeObjectId(seat.id)
+		return ExtTransientSeatV1{}, err
+	}
+
+	return seat, nil
 }
 
-func (object ExtTransientSeatManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtTransientSeatManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// ExtTransientSeatV1 is the ext_transient_seat_v1 interface
 type ExtTransientSeatV1 Object
 
-func (object ExtTransientSeatV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtTransientSeatV1) Interface() string {
+	return "ext_transient_seat_v1"
 }
 
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtTransientSeatV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnReady registers a listener for the ready event
 func (object ExtTransientSeatV1) OnReady(listener func(globalName uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnDenied registers a listener for the denied event
 func (object ExtTransientSeatV1) OnDenied(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtWorkspaceManagerV1 is the ext_workspace_manager_v1 interface
 type ExtWorkspaceManagerV1 Object
 
-func (object ExtWorkspaceManagerV1) Commit() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtWorkspaceManagerV1) Interface() string {
+	return "ext_workspace_manager_v1"
+}
+
+// Commit sends the commit request
+func (object ExtWorkspaceManagerV1) Commit() error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0))
 }
 
-func (object ExtWorkspaceManagerV1) Stop() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Stop sends the stop request
+func (object ExtWorkspaceManagerV1) Stop() error {
+	if err := Object(object).checkRequest("stop", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1))
 }
 
+// OnWorkspaceGroup registers a listener for the workspace_group event
 func (object ExtWorkspaceManagerV1) OnWorkspaceGroup(listener func(workspaceGroup ExtWorkspaceGroupHandleV1)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(ExtWorkspaceGroupHandleV1(Object{client: object.client, id: object.client.NewObjectId(), iface: "ext_workspace_group_handle_v1"}))
+		listener(ExtWorkspaceGroupHandleV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnWorkspace registers a listener for the workspace event
 func (object ExtWorkspaceManagerV1) OnWorkspace(listener func(workspace ExtWorkspaceHandleV1)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(ExtWorkspaceHandleV1(Object{client: object.client, id: object.client.NewObjectId(), iface: "ext_workspace_handle_v1"}))
+		listener(ExtWorkspaceHandleV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object ExtWorkspaceManagerV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnFinished registers a listener for the finished event
 func (object ExtWorkspaceManagerV1) OnFinished(listener func()) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtWorkspaceGroupHandleV1 is the ext_workspace_group_handle_v1 interface
 type ExtWorkspaceGroupHandleV1 Object
 
-func (object ExtWorkspaceGroupHandleV1) CreateWorkspace(workspace string) {
-	object.client.Write(wayland.NewMessage(object.id, 0, workspace))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtWorkspaceGroupHandleV1) Interface() string {
+	return "ext_workspace_group_handle_v1"
+}
+
+// CreateWorkspace sends the create_workspace request
+func (object ExtWorkspaceGroupHandleV1) CreateWorkspace(workspace string) error {
+	if err := Object(object).checkRequest("create_workspace", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, workspace))
 }
 
-func (object ExtWorkspaceGroupHandleV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtWorkspaceGroupHandleV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnCapabilities registers a listener for the capabilities event
 func (object ExtWorkspaceGroupHandleV1) OnCapabilities(listener func(capabilities uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnOutputEnter registers a listener for the output_enter event
 func (object ExtWorkspaceGroupHandleV1) OnOutputEnter(listener func(output WlOutput)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
-		listener(WlOutput(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(WlOutput(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnOutputLeave registers a listener for the output_leave event
 func (object ExtWorkspaceGroupHandleV1) OnOutputLeave(listener func(output WlOutput)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
-		listener(WlOutput(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(WlOutput(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnWorkspaceEnter registers a listener for the workspace_enter event
 func (object ExtWorkspaceGroupHandleV1) OnWorkspaceEnter(listener func(workspace ExtWorkspaceHandleV1)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
-		listener(ExtWorkspaceHandleV1(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(ExtWorkspaceHandleV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnWorkspaceLeave registers a listener for the workspace_leave event
 func (object ExtWorkspaceGroupHandleV1) OnWorkspaceLeave(listener func(workspace ExtWorkspaceHandleV1)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
-		listener(ExtWorkspaceHandleV1(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(ExtWorkspaceHandleV1(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// OnRemoved registers a listener for the removed event
 func (object ExtWorkspaceGroupHandleV1) OnRemoved(listener func()) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// ExtWorkspaceHandleV1 is the ext_workspace_handle_v1 interface
 type ExtWorkspaceHandleV1 Object
 
-func (object ExtWorkspaceHandleV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (ExtWorkspaceHandleV1) Interface() string {
+	return "ext_workspace_handle_v1"
 }
 
-func (object ExtWorkspaceHandleV1) Activate() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ExtWorkspaceHandleV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Activate sends the activate request
+func (object ExtWorkspaceHandleV1) Activate() error {
+	if err := Object(object).checkRequest("activate", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1))
 }
 
-func (object ExtWorkspaceHandleV1) Deactivate() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Deactivate sends the deactivate request
+func (object ExtWorkspaceHandleV1) Deactivate() error {
+	if err := Object(object).checkRequest("deactivate", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2))
 }
 
-func (object ExtWorkspaceHandleV1) Assign(workspaceGroup ExtWorkspaceGroupHandleV1) {
-	object.client.Write(wayland.NewMessage(object.id, 3, workspaceGroup.id))
+// Assign sends the assign request
+func (object ExtWorkspaceHandleV1) Assign(workspaceGroup ExtWorkspaceGroupHandleV1) error {
+	if err := Object(object).checkRequest("assign", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, workspaceGroup.id))
 }
 
-func (object ExtWorkspaceHandleV1) Remove() {
-	object.client.Write(wayland.NewMessage(object.id, 4))
+// Remove sends the remove request
+func (object ExtWorkspaceHandleV1) Remove() error {
+	if err := Object(object).checkRequest("remove", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4))
 }
 
+// OnId registers a listener for the id event
 func (object ExtWorkspaceHandleV1) OnId(listener func(id string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnName registers a listener for the name event
 func (object ExtWorkspaceHandleV1) OnName(listener func(name string)) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
-func (object ExtWorkspaceHandleV1) OnCoordinates(listener func(coordinates []uint32)) chan struct{} {
+// OnCoordinates registers a listener for the coordinates event
+func (object ExtWorkspaceHandleV1) OnCoordinates(listener func(coordinates wayland.Array)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadArray())
 	})
 }
 
+// OnState registers a listener for the state event
 func (object ExtWorkspaceHandleV1) OnState(listener func(state uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnCapabilities registers a listener for the capabilities event
 func (object ExtWorkspaceHandleV1) OnCapabilities(listener func(capabilities uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnRemoved registers a listener for the removed event
 func (object ExtWorkspaceHandleV1) OnRemoved(listener func()) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// WpFifoManagerV1 is the wp_fifo_manager_v1 interface
 type WpFifoManagerV1 Object
 
-func (object WpFifoManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpFifoManagerV1) Interface() string {
+	return "wp_fifo_manager_v1"
 }
 
-func (object WpFifoManagerV1) GetFifo(surface WlSurface) WpFifoV1 {
-	id := WpFifoV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_fifo_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpFifoManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+// GetFifo sends the get_fifo request
+func (object WpFifoManagerV1) GetFifo(surface WlSurface) (WpFifoV1, error) {
+	if err := Object(object).checkRequest("get_fifo", 1); err != nil {
+		return WpFifoV1{}, err
+	}
+
+	id := WpFifoV1(object.client.newObject("wp_fifo_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpFifoV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WpFifoV1 is the wp_fifo_v1 interface
 type WpFifoV1 Object
 
-func (object WpFifoV1) SetBarrier() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpFifoV1) Interface() string {
+	return "wp_fifo_v1"
+}
+
+// SetBarrier sends the set_barrier request
+func (object WpFifoV1) SetBarrier() error {
+	if err := Object(object).checkRequest("set_barrier", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0))
 }
 
-func (object WpFifoV1) WaitBarrier() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// WaitBarrier sends the wait_barrier request
+func (object WpFifoV1) WaitBarrier() error {
+	if err := Object(object).checkRequest("wait_barrier", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1))
 }
 
-func (object WpFifoV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpFifoV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
 }
 
+// WpFractionalScaleManagerV1 is the wp_fractional_scale_manager_v1 interface
 type WpFractionalScaleManagerV1 Object
 
-func (object WpFractionalScaleManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpFractionalScaleManagerV1) Interface() string {
+	return "wp_fractional_scale_manager_v1"
 }
 
-func (object WpFractionalScaleManagerV1) GetFractionalScale(surface WlSurface) WpFractionalScaleV1 {
-	id := WpFractionalScaleV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_fractional_scale_v1",
-	})
+// WpFractionalScaleManagerV1Error is the error enum of wp_fractional_scale_manager_v1
+type WpFractionalScaleManagerV1Error uint32
+
+const (
+	WpFractionalScaleManagerV1ErrorFractionalScaleExists WpFractionalScaleManagerV1Error = 0
+)
+
+func (value WpFractionalScaleManagerV1Error) String() string {
+	switch value {
+	case WpFractionalScaleManagerV1ErrorFractionalScaleExists:
+		return "fractional_scale_exists"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpFractionalScaleManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetFractionalScale sends the get_fractional_scale request
+func (object WpFractionalScaleManagerV1) GetFractionalScale(surface WlSurface) (WpFractionalScaleV1, error) {
+	if err := Object(object).checkRequest("get_fractional_scale", 1); err != nil {
+		return WpFractionalScaleV1{}, err
+	}
+
+	id := WpFractionalScaleV1(object.client.newObject("wp_fractional_scale_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpFractionalScaleV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// WpFractionalScaleV1 is the wp_fractional_scale_v1 interface
 type WpFractionalScaleV1 Object
 
-func (object WpFractionalScaleV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpFractionalScaleV1) Interface() string {
+	return "wp_fractional_scale_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpFractionalScaleV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
+// OnPreferredScale registers a listener for the preferred_scale event
 func (object WpFractionalScaleV1) OnPreferredScale(listener func(scale uint32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// WpLinuxDrmSyncobjManagerV1 is the wp_linux_drm_syncobj_manager_v1 interface
 type WpLinuxDrmSyncobjManagerV1 Object
 
-func (object WpLinuxDrmSyncobjManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpLinuxDrmSyncobjManagerV1) Interface() string {
+	return "wp_linux_drm_syncobj_manager_v1"
 }
 
-func (object WpLinuxDrmSyncobjManagerV1) GetSurface(surface WlSurface) WpLinuxDrmSyncobjSurfaceV1 {
-	id := WpLinuxDrmSyncobjSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_linux_drm_syncobj_surface_v1",
-	})
-
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpLinuxDrmSyncobjManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpLinuxDrmSyncobjManagerV1) ImportTimeline(fd int) WpLinuxDrmSyncobjTimelineV1 {
-	id := WpLinuxDrmSyncobjTimelineV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_linux_drm_syncobj_timeline_v1",
-	})
+// GetSurface sends the get_surface request
+func (object WpLinuxDrmSyncobjManagerV1) GetSurface(surface WlSurface) (WpLinuxDrmSyncobjSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_surface", 1); err != nil {
+		return WpLinuxDrmSyncobjSurfaceV1{}, err
+	}
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id).WithFds(fd))
+	id := WpLinuxDrmSyncobjSurfaceV1(object.client.newObject("wp_linux_drm_syncobj_surface_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpLinuxDrmSyncobjSurfaceV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-type WpLinuxDrmSyncobjTimelineV1 Object
+// ImportTimeline sends the import_timeline request
+func (object WpLinuxDrmSyncobjManagerV1) ImportTimeline(fd int) (WpLinuxDrmSyncobjTimelineV1, error) {
+	if err := Object(object).checkRequest("import_timeline", 1); err != nil {
+		return WpLinuxDrmSyncobjTimelineV1{}, err
+	}
 
-func (object WpLinuxDrmSyncobjTimelineV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
-}
+	id := WpLinuxDrmSyncobjTimelineV1(object.client.newObject("wp_linux_drm_syncobj_timeline_v1", object.version))
 
-type WpLinuxDrmSyncobjSurfaceV1 Object
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id).WithFds(fd)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpLinuxDrmSyncobjTimelineV1{}, err
+	}
 
-func (object WpLinuxDrmSyncobjSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return id, nil
 }
 
-func (object WpLinuxDrmSyncobjSurfaceV1) SetAcquirePoint(timeline WpLinuxDrmSyncobjTimelineV1, pointHi uint32, pointLo uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, timeline.id, pointHi, pointLo))
-}
+// WpLinuxDrmSyncobjTimelineV1 is the wp_linux_drm_syncobj_timeline_v1 interface
+type WpLinuxDrmSyncobjTimelineV1 Object
 
-func (object WpLinuxDrmSyncobjSurfaceV1) SetReleasePoint(timeline WpLinuxDrmSyncobjTimelineV1, pointHi uint32, pointLo uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, timeline.id, pointHi, pointLo))
+// Interface returns the name of the interface, which is used by Bind
+func (WpLinuxDrmSyncobjTimelineV1) Interface() string {
+	return "wp_linux_drm_syncobj_timeline_v1"
 }
 
-type WpPointerWarpV1 Object
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpLinuxDrmSyncobjTimelineV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object WpPointerWarpV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpPointerWarpV1) WarpPointer(surface WlSurface, pointer WlPointer, x wayland.Fixed, y wayland.Fixed, serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, surface.id, pointer.id, x, y, serial))
+// WpLinuxDrmSyncobjSurfaceV1 is the wp_linux_drm_syncobj_surface_v1 interface
+type WpLinuxDrmSyncobjSurfaceV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WpLinuxDrmSyncobjSurfaceV1) Interface() string {
+	return "wp_linux_drm_syncobj_surface_v1"
 }
 
-type WpSecurityContextManagerV1 Object
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpLinuxDrmSyncobjSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object WpSecurityContextManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpSecurityContextManagerV1) CreateListener(listenFd int, closeFd int) WpSecurityContextV1 {
-	id := WpSecurityContextV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_security_context_v1",
-	})
-
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id).WithFds(listenFd, closeFd))
+// SetAcquirePoint sends the set_acquire_point request
+func (object WpLinuxDrmSyncobjSurfaceV1) SetAcquirePoint(timeline WpLinuxDrmSyncobjTimelineV1, pointHi uint32, pointLo uint32) error {
+	if err := Object(object).checkRequest("set_acquire_point", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.Write(wayland.NewMessage(object.id, 1, timeline.id, pointHi, pointLo))
 }
 
-type WpSecurityContextV1 Object
+// SetReleasePoint sends the set_release_point request
+func (object WpLinuxDrmSyncobjSurfaceV1) SetReleasePoint(timeline WpLinuxDrmSyncobjTimelineV1, pointHi uint32, pointLo uint32) error {
+	if err := Object(object).checkRequest("set_release_point", 1); err != nil {
+		return err
+	}
 
-func (object WpSecurityContextV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.Write(wayland.NewMessage(object.id, 2, timeline.id, pointHi, pointLo))
 }
 
-func (object WpSecurityContextV1) SetSandboxEngine(name string) {
-	object.client.Write(wayland.NewMessage(object.id, 1, name))
-}
+// WpPointerWarpV1 is the wp_pointer_warp_v1 interface
+type WpPointerWarpV1 Object
 
-func (object WpSecurityContextV1) SetAppId(appId string) {
-	object.client.Write(wayland.NewMessage(object.id, 2, appId))
+// Interface returns the name of the interface, which is used by Bind
+func (WpPointerWarpV1) Interface() string {
+	return "wp_pointer_warp_v1"
 }
 
-func (object WpSecurityContextV1) SetInstanceId(instanceId string) {
-	object.client.Write(wayland.NewMessage(object.id, 3, instanceId))
-}
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpPointerWarpV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object WpSecurityContextV1) Commit() {
-	object.client.Write(wayland.NewMessage(object.id, 4))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-type WpSinglePixelBufferManagerV1 Object
+// WarpPointer sends the warp_pointer request
+func (object WpPointerWarpV1) WarpPointer(surface WlSurface, pointer WlPointer, x wayland.Fixed, y wayland.Fixed, serial uint32) error {
+	if err := Object(object).checkRequest("warp_pointer", 1); err != nil {
+		return err
+	}
 
-func (object WpSinglePixelBufferManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.Write(wayland.NewMessage(object.id, 1, surface.id, pointer.id, x, y, serial))
 }
 
-func (object WpSinglePixelBufferManagerV1) CreateU32RgbaBuffer(r uint32, g uint32, b uint32, a uint32) WlBuffer {
-	id := WlBuffer(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wl_buffer",
-	})
-
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, r, g, b, a))
+// WpSecurityContextManagerV1 is the wp_security_context_manager_v1 interface
+type WpSecurityContextManagerV1 Object
 
-	return id
+// Interface returns the name of the interface, which is used by Bind
+func (WpSecurityContextManagerV1) Interface() string {
+	return "wp_security_context_manager_v1"
 }
 
-type WpTearingControlManagerV1 Object
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpSecurityContextManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object WpTearingControlManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object WpTearingControlManagerV1) GetTearingControl(surface WlSurface) WpTearingControlV1 {
-	id := WpTearingControlV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "wp_tearing_control_v1",
-	})
+// CreateListener sends the create_listener request
+func (object WpSecurityContextManagerV1) CreateListener(listenFd int, closeFd int) (WpSecurityContextV1, error) {
+	if err := Object(object).checkRequest("create_listener", 1); err != nil {
+		return WpSecurityContextV1{}, err
+	}
+
+	id := WpSecurityContextV1(object.client.newObject("wp_security_context_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id).WithFds(listenFd, closeFd)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpSecurityContextV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-type WpTearingControlV1 Object
+// WpSecurityContextV1 is the wp_security_context_v1 interface
+type WpSecurityContextV1 Object
 
-func (object WpTearingControlV1) SetPresentationHint(hint uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, hint))
+// Interface returns the name of the interface, which is used by Bind
+func (WpSecurityContextV1) Interface() string {
+	return "wp_security_context_v1"
 }
 
-func (object WpTearingControlV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpSecurityContextV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-type XdgActivationV1 Object
+// SetSandboxEngine sends the set_sandbox_engine request
+func (object WpSecurityContextV1) SetSandboxEngine(name string) error {
+	if err := Object(object).checkRequest("set_sandbox_engine", 1); err != nil {
+		return err
+	}
 
-func (object XdgActivationV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.Write(wayland.NewMessage(object.id, 1, name))
 }
 
-func (object XdgActivationV1) GetActivationToken() XdgActivationTokenV1 {
-	id := XdgActivationTokenV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_activation_token_v1",
-	})
-
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+// SetAppId sends the set_app_id request
+func (object WpSecurityContextV1) SetAppId(appId string) error {
+	if err := Object(object).checkRequest("set_app_id", 1); err != nil {
+		return err
+	}
 
-	return id
+	return object.client.Write(wayland.NewMessage(object.id, 2, appId))
 }
 
-func (object XdgActivationV1) Activate(token string, surface WlSurface) {
-	object.client.Write(wayland.NewMessage(object.id, 2, token, surface.id))
+// SetInstanceId sends the set_instance_id request
+func (object WpSecurityContextV1) SetInstanceId(instanceId string) error {
+	if err := Object(object).checkRequest("set_instance_id", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, instanceId))
 }
 
-type XdgActivationTokenV1 Object
+// Commit sends the commit request
+func (object WpSecurityContextV1) Commit() error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
 
-func (object XdgActivationTokenV1) SetSerial(serial uint32, seat WlSeat) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serial, seat.id))
+	return object.client.Write(wayland.NewMessage(object.id, 4))
 }
 
-func (object XdgActivationTokenV1) SetAppId(appId string) {
-	object.client.Write(wayland.NewMessage(object.id, 1, appId))
-}
+// WpSinglePixelBufferManagerV1 is the wp_single_pixel_buffer_manager_v1 interface
+type WpSinglePixelBufferManagerV1 Object
 
-func (object XdgActivationTokenV1) SetSurface(surface WlSurface) {
-	object.client.Write(wayland.NewMessage(object.id, 2, surface.id))
+// Interface returns the name of the interface, which is used by Bind
+func (WpSinglePixelBufferManagerV1) Interface() string {
+	return "wp_single_pixel_buffer_manager_v1"
 }
 
-func (object XdgActivationTokenV1) Commit() {
-	object.client.Write(wayland.NewMessage(object.id, 3))
-}
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpSinglePixelBufferManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object XdgActivationTokenV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 4))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgActivationTokenV1) OnDone(listener func(token string)) chan struct{} {
-	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(message.ReadString())
-	})
+// CreateU32RgbaBuffer sends the create_u32_rgba_buffer request
+func (object WpSinglePixelBufferManagerV1) CreateU32RgbaBuffer(r uint32, g uint32, b uint32, a uint32) (WlBuffer, error) {
+	if err := Object(object).checkRequest("create_u32_rgba_buffer", 1); err != nil {
+		return WlBuffer{}, err
+	}
+
+	id := WlBuffer(object.client.newObject("wl_buffer", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, r, g, b, a)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WlBuffer{}, err
+	}
+
+	return id, nil
 }
 
-type XdgWmDialogV1 Object
+// WpTearingControlManagerV1 is the wp_tearing_control_manager_v1 interface
+type WpTearingControlManagerV1 Object
 
-func (object XdgWmDialogV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (WpTearingControlManagerV1) Interface() string {
+	return "wp_tearing_control_manager_v1"
 }
 
-func (object XdgWmDialogV1) GetXdgDialog(toplevel XdgToplevel) XdgDialogV1 {
-	id := XdgDialogV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_dialog_v1",
-	})
+// WpTearingControlManagerV1Error is the error enum of wp_tearing_control_manager_v1
+type WpTearingControlManagerV1Error uint32
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, toplevel.id))
+const (
+	WpTearingControlManagerV1ErrorTearingControlExists WpTearingControlManagerV1Error = 0
+)
 
-	return id
+func (value WpTearingControlManagerV1Error) String() string {
+	switch value {
+	case WpTearingControlManagerV1ErrorTearingControlExists:
+		return "tearing_control_exists"
+	}
+	return strconv.FormatUint(uint64(value), 10)
 }
 
-type XdgDialogV1 Object
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpTearingControlManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
 
-func (object XdgDialogV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgDialogV1) SetModal() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
-}
+// GetTearingControl sends the get_tearing_control request
+func (object WpTearingControlManagerV1) GetTearingControl(surface WlSurface) (WpTearingControlV1, error) {
+	if err := Object(object).checkRequest("get_tearing_control", 1); err != nil {
+		return WpTearingControlV1{}, err
+	}
 
-func (object XdgDialogV1) UnsetModal() {
-	object.client.Write(wayland.NewMessage(object.id, 2))
-}
+	id := WpTearingControlV1(object.client.newObject("wp_tearing_control_v1", object.version))
 
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return WpTearingControlV1{}, err
+	}
+
+	return id, nil
+}
+
+// WpTearingControlV1 is the wp_tearing_control_v1 interface
+type WpTearingControlV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WpTearingControlV1) Interface() string {
+	return "wp_tearing_control_v1"
+}
+
+// WpTearingControlV1PresentationHint is the presentation_hint enum of wp_tearing_control_v1
+type WpTearingControlV1PresentationHint uint32
+
+const (
+	WpTearingControlV1PresentationHintVsync WpTearingControlV1PresentationHint = 0
+	WpTearingControlV1PresentationHintAsync WpTearingControlV1PresentationHint = 1
+)
+
+func (value WpTearingControlV1PresentationHint) String() string {
+	switch value {
+	case WpTearingControlV1PresentationHintVsync:
+		return "vsync"
+	case WpTearingControlV1PresentationHintAsync:
+		return "async"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// SetPresentationHint sends the set_presentation_hint request
+func (object WpTearingControlV1) SetPresentationHint(hint WpTearingControlV1PresentationHint) error {
+	if err := Object(object).checkRequest("set_presentation_hint", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, uint32(hint)))
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WpTearingControlV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// XdgActivationV1 is the xdg_activation_v1 interface
+type XdgActivationV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (XdgActivationV1) Interface() string {
+	return "xdg_activation_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgActivationV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetActivationToken sends the get_activation_token request
+func (object XdgActivationV1) GetActivationToken() (XdgActivationTokenV1, error) {
+	if err := Object(object).checkRequest("get_activation_token", 1); err != nil {
+		return XdgActivationTokenV1{}, err
+	}
+
+	id := XdgActivationTokenV1(object.client.newObject("xdg_activation_token_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgActivationTokenV1{}, err
+	}
+
+	return id, nil
+}
+
+// Activate sends the activate request
+func (object XdgActivationV1) Activate(token string, surface WlSurface) error {
+	if err := Object(object).checkRequest("activate", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, token, surface.id))
+}
+
+// XdgActivationTokenV1 is the xdg_activation_token_v1 interface
+type XdgActivationTokenV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (XdgActivationTokenV1) Interface() string {
+	return "xdg_activation_token_v1"
+}
+
+// SetSerial sends the set_serial request
+func (object XdgActivationTokenV1) SetSerial(serial uint32, seat WlSeat) error {
+	if err := Object(object).checkRequest("set_serial", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, serial, seat.id))
+}
+
+// SetAppId sends the set_app_id request
+func (object XdgActivationTokenV1) SetAppId(appId string) error {
+	if err := Object(object).checkRequest("set_app_id", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, appId))
+}
+
+// SetSurface sends the set_surface request
+func (object XdgActivationTokenV1) SetSurface(surface WlSurface) error {
+	if err := Object(object).checkRequest("set_surface", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, surface.id))
+}
+
+// Commit sends the commit request
+func (object XdgActivationTokenV1) Commit() error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3))
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgActivationTokenV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 4))
+}
+
+// OnDone registers a listener for the done event
+func (object XdgActivationTokenV1) OnDone(listener func(token string)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// XdgWmDialogV1 is the xdg_wm_dialog_v1 interface
+type XdgWmDialogV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (XdgWmDialogV1) Interface() string {
+	return "xdg_wm_dialog_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgWmDialogV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetXdgDialog sends the get_xdg_dialog request
+func (object XdgWmDialogV1) GetXdgDialog(toplevel XdgToplevel) (XdgDialogV1, error) {
+	if err := Object(object).checkRequest("get_xdg_dialog", 1); err != nil {
+		return XdgDialogV1{}, err
+	}
+
+	id := XdgDialogV1(object.client.newObject("xdg_dialog_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, toplevel.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgDialogV1{}, err
+	}
+
+	return id, nil
+}
+
+// XdgDialogV1 is the xdg_dialog_v1 interface
+type XdgDialogV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (XdgDialogV1) Interface() string {
+	return "xdg_dialog_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgDialogV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetModal sends the set_modal request
+func (object XdgDialogV1) SetModal() error {
+	if err := Object(object).checkRequest("set_modal", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1))
+}
+
+// UnsetModal sends the unset_modal request
+func (object XdgDialogV1) UnsetModal() error {
+	if err := Object(object).checkRequest("unset_modal", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2))
+}
+
+// XdgSystemBellV1 is the xdg_system_bell_v1 interface
 type XdgSystemBellV1 Object
 
-func (object XdgSystemBellV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgSystemBellV1) Interface() string {
+	return "xdg_system_bell_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgSystemBellV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgSystemBellV1) Ring(surface WlSurface) {
-	object.client.Write(wayland.NewMessage(object.id, 1, surface.id))
+// Ring sends the ring request
+//
+// Arguments:
+//   - surface: nil for null
+func (object XdgSystemBellV1) Ring(surface *WlSurface) error {
+	if err := Object(object).checkRequest("ring", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, nullableId(surface)))
 }
 
+// XdgToplevelDragManagerV1 is the xdg_toplevel_drag_manager_v1 interface
 type XdgToplevelDragManagerV1 Object
 
-func (object XdgToplevelDragManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevelDragManagerV1) Interface() string {
+	return "xdg_toplevel_drag_manager_v1"
 }
 
-func (object XdgToplevelDragManagerV1) GetXdgToplevelDrag(dataSource WlDataSource) XdgToplevelDragV1 {
-	id := XdgToplevelDragV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_toplevel_drag_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevelDragManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetXdgToplevelDrag sends the get_xdg_toplevel_drag request
+func (object XdgToplevelDragManagerV1) GetXdgToplevelDrag(dataSource WlDataSource) (XdgToplevelDragV1, error) {
+	if err := Object(object).checkRequest("get_xdg_toplevel_drag", 1); err != nil {
+		return XdgToplevelDragV1{}, err
+	}
+
+	id := XdgToplevelDragV1(object.client.newObject("xdg_toplevel_drag_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, dataSource.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, dataSource.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgToplevelDragV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// XdgToplevelDragV1 is the xdg_toplevel_drag_v1 interface
 type XdgToplevelDragV1 Object
 
-func (object XdgToplevelDragV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevelDragV1) Interface() string {
+	return "xdg_toplevel_drag_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevelDragV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgToplevelDragV1) Attach(toplevel XdgToplevel, xOffset int32, yOffset int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, toplevel.id, xOffset, yOffset))
+// Attach sends the attach request
+func (object XdgToplevelDragV1) Attach(toplevel XdgToplevel, xOffset int32, yOffset int32) error {
+	if err := Object(object).checkRequest("attach", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, toplevel.id, xOffset, yOffset))
 }
 
+// XdgToplevelIconManagerV1 is the xdg_toplevel_icon_manager_v1 interface
 type XdgToplevelIconManagerV1 Object
 
-func (object XdgToplevelIconManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevelIconManagerV1) Interface() string {
+	return "xdg_toplevel_icon_manager_v1"
 }
 
-func (object XdgToplevelIconManagerV1) CreateIcon() XdgToplevelIconV1 {
-	id := XdgToplevelIconV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xdg_toplevel_icon_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevelIconManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// CreateIcon sends the create_icon request
+func (object XdgToplevelIconManagerV1) CreateIcon() (XdgToplevelIconV1, error) {
+	if err := Object(object).checkRequest("create_icon", 1); err != nil {
+		return XdgToplevelIconV1{}, err
+	}
+
+	id := XdgToplevelIconV1(object.client.newObject("xdg_toplevel_icon_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XdgToplevelIconV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object XdgToplevelIconManagerV1) SetIcon(toplevel XdgToplevel, icon XdgToplevelIconV1) {
-	object.client.Write(wayland.NewMessage(object.id, 2, toplevel.id, icon.id))
+// SetIcon sends the set_icon request
+//
+// Arguments:
+//   - icon: nil for null
+func (object XdgToplevelIconManagerV1) SetIcon(toplevel XdgToplevel, icon *XdgToplevelIconV1) error {
+	if err := Object(object).checkRequest("set_icon", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, toplevel.id, nullableId(icon)))
 }
 
+// OnIconSize registers a listener for the icon_size event
 func (object XdgToplevelIconManagerV1) OnIconSize(listener func(size int32)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadInt32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object XdgToplevelIconManagerV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// XdgToplevelIconV1 is the xdg_toplevel_icon_v1 interface
 type XdgToplevelIconV1 Object
 
-func (object XdgToplevelIconV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevelIconV1) Interface() string {
+	return "xdg_toplevel_icon_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevelIconV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgToplevelIconV1) SetName(iconName string) {
-	object.client.Write(wayland.NewMessage(object.id, 1, iconName))
+// SetName sends the set_name request
+func (object XdgToplevelIconV1) SetName(iconName string) error {
+	if err := Object(object).checkRequest("set_name", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, iconName))
 }
 
-func (object XdgToplevelIconV1) AddBuffer(buffer WlBuffer, scale int32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, buffer.id, scale))
+// AddBuffer sends the add_buffer request
+func (object XdgToplevelIconV1) AddBuffer(buffer WlBuffer, scale int32) error {
+	if err := Object(object).checkRequest("add_buffer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, buffer.id, scale))
 }
 
+// XdgToplevelTagManagerV1 is the xdg_toplevel_tag_manager_v1 interface
 type XdgToplevelTagManagerV1 Object
 
-func (object XdgToplevelTagManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XdgToplevelTagManagerV1) Interface() string {
+	return "xdg_toplevel_tag_manager_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XdgToplevelTagManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XdgToplevelTagManagerV1) SetToplevelTag(toplevel XdgToplevel, tag string) {
-	object.client.Write(wayland.NewMessage(object.id, 1, toplevel.id, tag))
+// SetToplevelTag sends the set_toplevel_tag request
+func (object XdgToplevelTagManagerV1) SetToplevelTag(toplevel XdgToplevel, tag string) error {
+	if err := Object(object).checkRequest("set_toplevel_tag", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, toplevel.id, tag))
 }
 
-func (object XdgToplevelTagManagerV1) SetToplevelDescription(toplevel XdgToplevel, description string) {
-	object.client.Write(wayland.NewMessage(object.id, 2, toplevel.id, description))
+// SetToplevelDescription sends the set_toplevel_description request
+func (object XdgToplevelTagManagerV1) SetToplevelDescription(toplevel XdgToplevel, description string) error {
+	if err := Object(object).checkRequest("set_toplevel_description", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, toplevel.id, description))
 }
 
+// XwaylandShellV1 is the xwayland_shell_v1 interface
 type XwaylandShellV1 Object
 
-func (object XwaylandShellV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XwaylandShellV1) Interface() string {
+	return "xwayland_shell_v1"
 }
 
-func (object XwaylandShellV1) GetXwaylandSurface(surface WlSurface) XwaylandSurfaceV1 {
-	id := XwaylandSurfaceV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xwayland_surface_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XwaylandShellV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetXwaylandSurface sends the get_xwayland_surface request
+func (object XwaylandShellV1) GetXwaylandSurface(surface WlSurface) (XwaylandSurfaceV1, error) {
+	if err := Object(object).checkRequest("get_xwayland_surface", 1); err != nil {
+		return XwaylandSurfaceV1{}, err
+	}
+
+	id := XwaylandSurfaceV1(object.client.newObject("xwayland_surface_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XwaylandSurfaceV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// XwaylandSurfaceV1 is the xwayland_surface_v1 interface
 type XwaylandSurfaceV1 Object
 
-func (object XwaylandSurfaceV1) SetSerial(serialLo uint32, serialHi uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 0, serialLo, serialHi))
+// Interface returns the name of the interface, which is used by Bind
+func (XwaylandSurfaceV1) Interface() string {
+	return "xwayland_surface_v1"
+}
+
+// SetSerial sends the set_serial request
+func (object XwaylandSurfaceV1) SetSerial(serialLo uint32, serialHi uint32) error {
+	if err := Object(object).checkRequest("set_serial", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, serialLo, serialHi))
 }
 
-func (object XwaylandSurfaceV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XwaylandSurfaceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// XxInputMethodV1 is the xx_input_method_v1 interface
 type XxInputMethodV1 Object
 
-func (object XxInputMethodV1) CommitString(text string) {
-	object.client.Write(wayland.NewMessage(object.id, 0, text))
+// Interface returns the name of the interface, which is used by Bind
+func (XxInputMethodV1) Interface() string {
+	return "xx_input_method_v1"
+}
+
+// CommitString sends the commit_string request
+func (object XxInputMethodV1) CommitString(text string) error {
+	if err := Object(object).checkRequest("commit_string", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, text))
 }
 
-func (object XxInputMethodV1) SetPreeditString(text string, cursorBegin int32, cursorEnd int32) {
-	object.client.Write(wayland.NewMessage(object.id, 1, text, cursorBegin, cursorEnd))
+// SetPreeditString sends the set_preedit_string request
+func (object XxInputMethodV1) SetPreeditString(text string, cursorBegin int32, cursorEnd int32) error {
+	if err := Object(object).checkRequest("set_preedit_string", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, text, cursorBegin, cursorEnd))
 }
 
-func (object XxInputMethodV1) DeleteSurroundingText(beforeLength uint32, afterLength uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 2, beforeLength, afterLength))
+// DeleteSurroundingText sends the delete_surrounding_text request
+func (object XxInputMethodV1) DeleteSurroundingText(beforeLength uint32, afterLength uint32) error {
+	if err := Object(object).checkRequest("delete_surrounding_text", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, beforeLength, afterLength))
 }
 
-func (object XxInputMethodV1) Commit(serial uint32) {
-	object.client.Write(wayland.NewMessage(object.id, 3, serial))
+// Commit sends the commit request
+func (object XxInputMethodV1) Commit(serial uint32) error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, serial))
 }
 
-func (object XxInputMethodV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 4))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxInputMethodV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 4))
 }
 
+// OnActivate registers a listener for the activate event
 func (object XxInputMethodV1) OnActivate(listener func()) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnDeactivate registers a listener for the deactivate event
 func (object XxInputMethodV1) OnDeactivate(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnSurroundingText registers a listener for the surrounding_text event
 func (object XxInputMethodV1) OnSurroundingText(listener func(text string, cursor uint32, anchor uint32)) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener(message.ReadString(), message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnTextChangeCause registers a listener for the text_change_cause event
 func (object XxInputMethodV1) OnTextChangeCause(listener func(cause uint32)) chan struct{} {
 	return object.client.On(object.id, 3, func(message *wayland.Message) {
 		listener(message.ReadUint32())
 	})
 }
 
+// OnContentType registers a listener for the content_type event
 func (object XxInputMethodV1) OnContentType(listener func(hint uint32, purpose uint32)) chan struct{} {
 	return object.client.On(object.id, 4, func(message *wayland.Message) {
 		listener(message.ReadUint32(), message.ReadUint32())
 	})
 }
 
+// OnDone registers a listener for the done event
 func (object XxInputMethodV1) OnDone(listener func()) chan struct{} {
 	return object.client.On(object.id, 5, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnUnavailable registers a listener for the unavailable event
 func (object XxInputMethodV1) OnUnavailable(listener func()) chan struct{} {
 	return object.client.On(object.id, 6, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// XxInputMethodManagerV2 is the xx_input_method_manager_v2 interface
 type XxInputMethodManagerV2 Object
 
-func (object XxInputMethodManagerV2) GetInputMethod(seat WlSeat) XxInputMethodV1 {
-	inputMethod := XxInputMethodV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xx_input_method_v1",
-	})
+// Interface returns the name of the interface, which is used by Bind
+func (XxInputMethodManagerV2) Interface() string {
+	return "xx_input_method_manager_v2"
+}
+
+// GetInputMethod sends the get_input_method request
+func (object XxInputMethodManagerV2) GetInputMethod(seat WlSeat) (XxInputMethodV1, error) {
+	if err := Object(object).checkRequest("get_input_method", 1); err != nil {
+		return XxInputMethodV1{}, err
+	}
+
+	inputMethod := XxInputMethodV1(object.client.newObject("xx_input_method_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 0, seat.id, inputMethod.id))
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, seat.id, inputMethod.id)); err != nil {
+		object.client.FreeObjectId(inputMethod.id)
+		return XxInputMethodV1{}, err
+	}
 
-	return inputMethod
+	return inputMethod, nil
 }
 
-func (object XxInputMethodManagerV2) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxInputMethodManagerV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// XxSessionManagerV1 is the xx_session_manager_v1 interface
 type XxSessionManagerV1 Object
 
-func (object XxSessionManagerV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XxSessionManagerV1) Interface() string {
+	return "xx_session_manager_v1"
 }
 
-func (object XxSessionManagerV1) GetSession(reason uint32, session string) XxSessionV1 {
-	id := XxSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xx_session_v1",
-	})
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxSessionManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetSession sends the get_session request
+func (object XxSessionManagerV1) GetSession(reason uint32, session string) (XxSessionV1, error) {
+	if err := Object(object).checkRequest("get_session", 1); err != nil {
+		return XxSessionV1{}, err
+	}
+
+	id := XxSessionV1(object.client.newObject("xx_session_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 1, id.id, reason, session))
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, reason, session)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XxSessionV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// XxSessionV1 is the xx_session_v1 interface
 type XxSessionV1 Object
 
-func (object XxSessionV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XxSessionV1) Interface() string {
+	return "xx_session_v1"
 }
 
-func (object XxSessionV1) Remove() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxSessionV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XxSessionV1) AddToplevel(toplevel XdgToplevel, name string) XxToplevelSessionV1 {
-	id := XxToplevelSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xx_toplevel_session_v1",
-	})
+// Remove sends the remove request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxSessionV1) Remove() error {
+	if err := Object(object).checkRequest("remove", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// AddToplevel sends the add_toplevel request
+func (object XxSessionV1) AddToplevel(toplevel XdgToplevel, name string) (XxToplevelSessionV1, error) {
+	if err := Object(object).checkRequest("add_toplevel", 1); err != nil {
+		return XxToplevelSessionV1{}, err
+	}
+
+	id := XxToplevelSessionV1(object.client.newObject("xx_toplevel_session_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 2, id.id, toplevel.id, name))
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, toplevel.id, name)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XxToplevelSessionV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
-func (object XxSessionV1) RestoreToplevel(toplevel XdgToplevel, name string) XxToplevelSessionV1 {
-	id := XxToplevelSessionV1(Object{
-		client: object.client,
-		id: object.client.NewObjectId(),
-		iface: "xx_toplevel_session_v1",
-	})
+// RestoreToplevel sends the restore_toplevel request
+func (object XxSessionV1) RestoreToplevel(toplevel XdgToplevel, name string) (XxToplevelSessionV1, error) {
+	if err := Object(object).checkRequest("restore_toplevel", 1); err != nil {
+		return XxToplevelSessionV1{}, err
+	}
+
+	id := XxToplevelSessionV1(object.client.newObject("xx_toplevel_session_v1", object.version))
 
-	object.client.Write(wayland.NewMessage(object.id, 3, id.id, toplevel.id, name))
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, id.id, toplevel.id, name)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return XxToplevelSessionV1{}, err
+	}
 
-	return id
+	return id, nil
 }
 
+// OnCreated registers a listener for the created event
 func (object XxSessionV1) OnCreated(listener func(id string)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
 		listener(message.ReadString())
 	})
 }
 
+// OnRestored registers a listener for the restored event
 func (object XxSessionV1) OnRestored(listener func()) chan struct{} {
 	return object.client.On(object.id, 1, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// OnReplaced registers a listener for the replaced event
 func (object XxSessionV1) OnReplaced(listener func()) chan struct{} {
 	return object.client.On(object.id, 2, func(message *wayland.Message) {
 		listener()
 	})
 }
 
+// XxToplevelSessionV1 is the xx_toplevel_session_v1 interface
 type XxToplevelSessionV1 Object
 
-func (object XxToplevelSessionV1) Destroy() {
-	object.client.Write(wayland.NewMessage(object.id, 0))
+// Interface returns the name of the interface, which is used by Bind
+func (XxToplevelSessionV1) Interface() string {
+	return "xx_toplevel_session_v1"
+}
+
+// Destroy sends the destroy request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxToplevelSessionV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
 }
 
-func (object XxToplevelSessionV1) Remove() {
-	object.client.Write(wayland.NewMessage(object.id, 1))
+// Remove sends the remove request
+//
+// This is a destructor, after which the object can no longer be used.
+func (object XxToplevelSessionV1) Remove() error {
+	if err := Object(object).checkRequest("remove", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
 }
 
+// OnRestored registers a listener for the restored event
 func (object XxToplevelSessionV1) OnRestored(listener func(surface XdgToplevel)) chan struct{} {
 	return object.client.On(object.id, 0, func(message *wayland.Message) {
-		listener(XdgToplevel(Object{object.client, message.ReadUint32(), message.ReadString(), message.ReadUint32()}))
+		listener(XdgToplevel(object.client.resolve(message.ReadUint32())))
 	})
 }
 
+// ZwpIdleInhibitManagerV1 is the zwp_idle_inhibit_manager_v1 interface: control behavior when display idles
+type ZwpIdleInhibitManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpIdleInhibitManagerV1) Interface() string {
+	return "zwp_idle_inhibit_manager_v1"
+}
+
+// Destroy sends the destroy request: destroy the idle inhibitor object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpIdleInhibitManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// CreateInhibitor sends the create_inhibitor request: create a new inhibitor object
+//
+// Arguments:
+//   - surface: the surface that inhibits the idle behavior
+func (object ZwpIdleInhibitManagerV1) CreateInhibitor(surface WlSurface) (ZwpIdleInhibitorV1, error) {
+	if err := Object(object).checkRequest("create_inhibitor", 1); err != nil {
+		return ZwpIdleInhibitorV1{}, err
+	}
+
+	id := ZwpIdleInhibitorV1(object.client.newObject("zwp_idle_inhibitor_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpIdleInhibitorV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZwpIdleInhibitorV1 is the zwp_idle_inhibitor_v1 interface: context object for inhibiting idle behavior
+type ZwpIdleInhibitorV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpIdleInhibitorV1) Interface() string {
+	return "zwp_idle_inhibitor_v1"
+}
+
+// Destroy sends the destroy request: destroy the idle inhibitor object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpIdleInhibitorV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// ZwpKeyboardShortcutsInhibitManagerV1 is the zwp_keyboard_shortcuts_inhibit_manager_v1 interface: context object for
+// keyboard grab_manager
+type ZwpKeyboardShortcutsInhibitManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpKeyboardShortcutsInhibitManagerV1) Interface() string {
+	return "zwp_keyboard_shortcuts_inhibit_manager_v1"
+}
+
+// ZwpKeyboardShortcutsInhibitManagerV1Error is the error enum of zwp_keyboard_shortcuts_inhibit_manager_v1
+type ZwpKeyboardShortcutsInhibitManagerV1Error uint32
+
+const (
+	// the shortcuts are already inhibited for this surface
+	ZwpKeyboardShortcutsInhibitManagerV1ErrorAlreadyInhibited ZwpKeyboardShortcutsInhibitManagerV1Error = 0
+)
+
+func (value ZwpKeyboardShortcutsInhibitManagerV1Error) String() string {
+	switch value {
+	case ZwpKeyboardShortcutsInhibitManagerV1ErrorAlreadyInhibited:
+		return "already_inhibited"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: destroy the keyboard shortcuts inhibitor object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpKeyboardShortcutsInhibitManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// InhibitShortcuts sends the inhibit_shortcuts request: create a new keyboard shortcuts inhibitor object
+//
+// Arguments:
+//   - surface: the surface that inhibits the keyboard shortcuts behavior
+//   - seat: the wl_seat for which keyboard shortcuts should be disabled
+func (object ZwpKeyboardShortcutsInhibitManagerV1) InhibitShortcuts(surface WlSurface, seat WlSeat) (ZwpKeyboardShortcutsInhibitorV1, error) {
+	if err := Object(object).checkRequest("inhibit_shortcuts", 1); err != nil {
+		return ZwpKeyboardShortcutsInhibitorV1{}, err
+	}
+
+	id := ZwpKeyboardShortcutsInhibitorV1(object.client.newObject("zwp_keyboard_shortcuts_inhibitor_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpKeyboardShortcutsInhibitorV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZwpKeyboardShortcutsInhibitorV1 is the zwp_keyboard_shortcuts_inhibitor_v1 interface: context object for keyboard
+// shortcuts inhibitor
+type ZwpKeyboardShortcutsInhibitorV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpKeyboardShortcutsInhibitorV1) Interface() string {
+	return "zwp_keyboard_shortcuts_inhibitor_v1"
+}
+
+// Destroy sends the destroy request: delete this keyboard shortcuts inhibitor object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpKeyboardShortcutsInhibitorV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnActive registers a listener for the active event: shortcuts are inhibited
+func (object ZwpKeyboardShortcutsInhibitorV1) OnActive(listener func()) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnInactive registers a listener for the inactive event: shortcuts are restored
+func (object ZwpKeyboardShortcutsInhibitorV1) OnInactive(listener func()) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwpPointerConstraintsV1 is the zwp_pointer_constraints_v1 interface: constrain the movement of a pointer
+type ZwpPointerConstraintsV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPointerConstraintsV1) Interface() string {
+	return "zwp_pointer_constraints_v1"
+}
+
+// ZwpPointerConstraintsV1Error is the error enum of zwp_pointer_constraints_v1
+type ZwpPointerConstraintsV1Error uint32
+
+const (
+	// pointer constraint already requested on that surface
+	ZwpPointerConstraintsV1ErrorAlreadyConstrained ZwpPointerConstraintsV1Error = 1
+)
+
+func (value ZwpPointerConstraintsV1Error) String() string {
+	switch value {
+	case ZwpPointerConstraintsV1ErrorAlreadyConstrained:
+		return "already_constrained"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZwpPointerConstraintsV1Lifetime is the lifetime enum of zwp_pointer_constraints_v1: constraint lifetime
+type ZwpPointerConstraintsV1Lifetime uint32
+
+const (
+	ZwpPointerConstraintsV1LifetimeOneshot ZwpPointerConstraintsV1Lifetime = 1
+	ZwpPointerConstraintsV1LifetimePersistent ZwpPointerConstraintsV1Lifetime = 2
+)
+
+func (value ZwpPointerConstraintsV1Lifetime) String() string {
+	switch value {
+	case ZwpPointerConstraintsV1LifetimeOneshot:
+		return "oneshot"
+	case ZwpPointerConstraintsV1LifetimePersistent:
+		return "persistent"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: destroy the pointer constraints manager object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPointerConstraintsV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// LockPointer sends the lock_pointer request: lock pointer to a position
+//
+// Arguments:
+//   - surface: surface to lock pointer to
+//   - pointer: the pointer that should be locked
+//   - region: region of surface, nil for null
+//   - lifetime: lock lifetime
+func (object ZwpPointerConstraintsV1) LockPointer(surface WlSurface, pointer WlPointer, region *WlRegion, lifetime ZwpPointerConstraintsV1Lifetime) (ZwpLockedPointerV1, error) {
+	if err := Object(object).checkRequest("lock_pointer", 1); err != nil {
+		return ZwpLockedPointerV1{}, err
+	}
+
+	id := ZwpLockedPointerV1(object.client.newObject("zwp_locked_pointer_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id, pointer.id, nullableId(region), uint32(lifetime))); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpLockedPointerV1{}, err
+	}
+
+	return id, nil
+}
+
+// ConfinePointer sends the confine_pointer request: confine pointer to a region
+//
+// Arguments:
+//   - surface: surface to lock pointer to
+//   - pointer: the pointer that should be confined
+//   - region: region of surface, nil for null
+//   - lifetime: confinement lifetime
+func (object ZwpPointerConstraintsV1) ConfinePointer(surface WlSurface, pointer WlPointer, region *WlRegion, lifetime ZwpPointerConstraintsV1Lifetime) (ZwpConfinedPointerV1, error) {
+	if err := Object(object).checkRequest("confine_pointer", 1); err != nil {
+		return ZwpConfinedPointerV1{}, err
+	}
+
+	id := ZwpConfinedPointerV1(object.client.newObject("zwp_confined_pointer_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 2, id.id, surface.id, pointer.id, nullableId(region), uint32(lifetime))); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpConfinedPointerV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZwpLockedPointerV1 is the zwp_locked_pointer_v1 interface: receive relative pointer motion events
+type ZwpLockedPointerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpLockedPointerV1) Interface() string {
+	return "zwp_locked_pointer_v1"
+}
+
+// Destroy sends the destroy request: destroy the locked pointer object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpLockedPointerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetCursorPositionHint sends the set_cursor_position_hint request: set the pointer cursor position hint
+//
+// Arguments:
+//   - surfaceX: surface-local x coordinate
+//   - surfaceY: surface-local y coordinate
+func (object ZwpLockedPointerV1) SetCursorPositionHint(surfaceX wayland.Fixed, surfaceY wayland.Fixed) error {
+	if err := Object(object).checkRequest("set_cursor_position_hint", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, surfaceX, surfaceY))
+}
+
+// SetRegion sends the set_region request: set a new lock region
+//
+// Arguments:
+//   - region: region of surface, nil for null
+func (object ZwpLockedPointerV1) SetRegion(region *WlRegion) error {
+	if err := Object(object).checkRequest("set_region", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2, nullableId(region)))
+}
+
+// OnLocked registers a listener for the locked event: lock activation event
+func (object ZwpLockedPointerV1) OnLocked(listener func()) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnUnlocked registers a listener for the unlocked event: lock deactivation event
+func (object ZwpLockedPointerV1) OnUnlocked(listener func()) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwpConfinedPointerV1 is the zwp_confined_pointer_v1 interface: confined pointer object
+type ZwpConfinedPointerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpConfinedPointerV1) Interface() string {
+	return "zwp_confined_pointer_v1"
+}
+
+// Destroy sends the destroy request: destroy the confined pointer object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpConfinedPointerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetRegion sends the set_region request: set a new confine region
+//
+// Arguments:
+//   - region: region of surface, nil for null
+func (object ZwpConfinedPointerV1) SetRegion(region *WlRegion) error {
+	if err := Object(object).checkRequest("set_region", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, nullableId(region)))
+}
+
+// OnConfined registers a listener for the confined event: pointer confined
+func (object ZwpConfinedPointerV1) OnConfined(listener func()) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnUnconfined registers a listener for the unconfined event: pointer unconfined
+func (object ZwpConfinedPointerV1) OnUnconfined(listener func()) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwpPointerGesturesV1 is the zwp_pointer_gestures_v1 interface: touchpad gestures
+type ZwpPointerGesturesV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPointerGesturesV1) Interface() string {
+	return "zwp_pointer_gestures_v1"
+}
+
+// GetSwipeGesture sends the get_swipe_gesture request: get swipe gesture
+func (object ZwpPointerGesturesV1) GetSwipeGesture(pointer WlPointer) (ZwpPointerGestureSwipeV1, error) {
+	if err := Object(object).checkRequest("get_swipe_gesture", 1); err != nil {
+		return ZwpPointerGestureSwipeV1{}, err
+	}
+
+	id := ZwpPointerGestureSwipeV1(object.client.newObject("zwp_pointer_gesture_swipe_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpPointerGestureSwipeV1{}, err
+	}
+
+	return id, nil
+}
+
+// GetPinchGesture sends the get_pinch_gesture request: get pinch gesture
+func (object ZwpPointerGesturesV1) GetPinchGesture(pointer WlPointer) (ZwpPointerGesturePinchV1, error) {
+	if err := Object(object).checkRequest("get_pinch_gesture", 1); err != nil {
+		return ZwpPointerGesturePinchV1{}, err
+	}
+
+	id := ZwpPointerGesturePinchV1(object.client.newObject("zwp_pointer_gesture_pinch_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpPointerGesturePinchV1{}, err
+	}
+
+	return id, nil
+}
+
+// Release sends the release request: destroy the pointer gesture object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 2 of zwp_pointer_gestures_v1.
+func (object ZwpPointerGesturesV1) Release() error {
+	if err := Object(object).checkRequest("release", 2); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
+}
+
+// GetHoldGesture sends the get_hold_gesture request: get hold gesture
+//
+// Available since version 3 of zwp_pointer_gestures_v1.
+func (object ZwpPointerGesturesV1) GetHoldGesture(pointer WlPointer) (ZwpPointerGestureHoldV1, error) {
+	if err := Object(object).checkRequest("get_hold_gesture", 3); err != nil {
+		return ZwpPointerGestureHoldV1{}, err
+	}
+
+	id := ZwpPointerGestureHoldV1(object.client.newObject("zwp_pointer_gesture_hold_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 3, id.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpPointerGestureHoldV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZwpPointerGestureSwipeV1 is the zwp_pointer_gesture_swipe_v1 interface: a swipe gesture object
+type ZwpPointerGestureSwipeV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPointerGestureSwipeV1) Interface() string {
+	return "zwp_pointer_gesture_swipe_v1"
+}
+
+// Destroy sends the destroy request: destroy the pointer swipe gesture object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPointerGestureSwipeV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnBegin registers a listener for the begin event: multi-finger swipe begin
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - fingers: number of fingers
+func (object ZwpPointerGestureSwipeV1) OnBegin(listener func(serial uint32, time uint32, surface WlSurface, fingers uint32)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadUint32())
+	})
+}
+
+// OnUpdate registers a listener for the update event: multi-finger swipe motion
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - dx: delta x coordinate in surface coordinate space
+//   - dy: delta y coordinate in surface coordinate space
+func (object ZwpPointerGestureSwipeV1) OnUpdate(listener func(time uint32, dx wayland.Fixed, dy wayland.Fixed)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadFixed(), message.ReadFixed())
+	})
+}
+
+// OnEnd registers a listener for the end event: multi-finger swipe end
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - cancelled: 1 if the gesture was cancelled, 0 otherwise
+func (object ZwpPointerGestureSwipeV1) OnEnd(listener func(serial uint32, time uint32, cancelled int32)) chan struct{} {
+	return object.client.On(object.id, 2, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadInt32())
+	})
+}
+
+// ZwpPointerGesturePinchV1 is the zwp_pointer_gesture_pinch_v1 interface: a pinch gesture object
+type ZwpPointerGesturePinchV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPointerGesturePinchV1) Interface() string {
+	return "zwp_pointer_gesture_pinch_v1"
+}
+
+// Destroy sends the destroy request: destroy the pinch gesture object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPointerGesturePinchV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnBegin registers a listener for the begin event: multi-finger pinch begin
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - fingers: number of fingers
+func (object ZwpPointerGesturePinchV1) OnBegin(listener func(serial uint32, time uint32, surface WlSurface, fingers uint32)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadUint32())
+	})
+}
+
+// OnUpdate registers a listener for the update event: multi-finger pinch motion
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - dx: delta x coordinate in surface coordinate space
+//   - dy: delta y coordinate in surface coordinate space
+//   - scale: scale relative to the initial finger position
+//   - rotation: angle in degrees cw relative to the previous event
+func (object ZwpPointerGesturePinchV1) OnUpdate(listener func(time uint32, dx wayland.Fixed, dy wayland.Fixed, scale wayland.Fixed, rotation wayland.Fixed)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadFixed(), message.ReadFixed(), message.ReadFixed(), message.ReadFixed())
+	})
+}
+
+// OnEnd registers a listener for the end event: multi-finger pinch end
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - cancelled: 1 if the gesture was cancelled, 0 otherwise
+func (object ZwpPointerGesturePinchV1) OnEnd(listener func(serial uint32, time uint32, cancelled int32)) chan struct{} {
+	return object.client.On(object.id, 2, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadInt32())
+	})
+}
+
+// ZwpPointerGestureHoldV1 is the zwp_pointer_gesture_hold_v1 interface: a hold gesture object
+type ZwpPointerGestureHoldV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPointerGestureHoldV1) Interface() string {
+	return "zwp_pointer_gesture_hold_v1"
+}
+
+// Destroy sends the destroy request: destroy the hold gesture object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of zwp_pointer_gesture_hold_v1.
+func (object ZwpPointerGestureHoldV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 3); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnBegin registers a listener for the begin event: multi-finger hold begin
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - fingers: number of fingers
+//
+// Available since version 3 of zwp_pointer_gesture_hold_v1.
+func (object ZwpPointerGestureHoldV1) OnBegin(listener func(serial uint32, time uint32, surface WlSurface, fingers uint32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("begin", 3))
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), WlSurface(object.client.resolve(message.ReadUint32())), message.ReadUint32())
+	})
+}
+
+// OnEnd registers a listener for the end event: multi-finger hold end
+//
+// Arguments:
+//   - time: timestamp with millisecond granularity
+//   - cancelled: 1 if the gesture was cancelled, 0 otherwise
+//
+// Available since version 3 of zwp_pointer_gesture_hold_v1.
+func (object ZwpPointerGestureHoldV1) OnEnd(listener func(serial uint32, time uint32, cancelled int32)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("end", 3))
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadInt32())
+	})
+}
+
+// ZwpPrimarySelectionDeviceManagerV1 is the zwp_primary_selection_device_manager_v1 interface: X primary selection
+// emulation
+type ZwpPrimarySelectionDeviceManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPrimarySelectionDeviceManagerV1) Interface() string {
+	return "zwp_primary_selection_device_manager_v1"
+}
+
+// CreateSource sends the create_source request: create a new primary selection source
+func (object ZwpPrimarySelectionDeviceManagerV1) CreateSource() (ZwpPrimarySelectionSourceV1, error) {
+	if err := Object(object).checkRequest("create_source", 1); err != nil {
+		return ZwpPrimarySelectionSourceV1{}, err
+	}
+
+	id := ZwpPrimarySelectionSourceV1(object.client.newObject("zwp_primary_selection_source_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 0, id.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpPrimarySelectionSourceV1{}, err
+	}
+
+	return id, nil
+}
+
+// GetDevice sends the get_device request: create a new primary selection device
+func (object ZwpPrimarySelectionDeviceManagerV1) GetDevice(seat WlSeat) (ZwpPrimarySelectionDeviceV1, error) {
+	if err := Object(object).checkRequest("get_device", 1); err != nil {
+		return ZwpPrimarySelectionDeviceV1{}, err
+	}
+
+	id := ZwpPrimarySelectionDeviceV1(object.client.newObject("zwp_primary_selection_device_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpPrimarySelectionDeviceV1{}, err
+	}
+
+	return id, nil
+}
+
+// Destroy sends the destroy request: destroy the primary selection device manager
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPrimarySelectionDeviceManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 2))
+}
+
+// ZwpPrimarySelectionDeviceV1 is the zwp_primary_selection_device_v1 interface
+type ZwpPrimarySelectionDeviceV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPrimarySelectionDeviceV1) Interface() string {
+	return "zwp_primary_selection_device_v1"
+}
+
+// SetSelection sends the set_selection request: set the primary selection
+//
+// Arguments:
+//   - source: nil for null
+//   - serial: serial of the event that triggered this request
+func (object ZwpPrimarySelectionDeviceV1) SetSelection(source *ZwpPrimarySelectionSourceV1, serial uint32) error {
+	if err := Object(object).checkRequest("set_selection", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, nullableId(source), serial))
+}
+
+// Destroy sends the destroy request: destroy the primary selection device
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPrimarySelectionDeviceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// OnDataOffer registers a listener for the data_offer event: introduce a new wp_primary_selection_offer
+func (object ZwpPrimarySelectionDeviceV1) OnDataOffer(listener func(offer ZwpPrimarySelectionOfferV1)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(ZwpPrimarySelectionOfferV1(object.client.resolve(message.ReadUint32())))
+	})
+}
+
+// OnSelection registers a listener for the selection event: advertise a new primary selection
+//
+// Arguments:
+//   - id: nil for null
+func (object ZwpPrimarySelectionDeviceV1) OnSelection(listener func(id *ZwpPrimarySelectionOfferV1)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(resolveNullable[ZwpPrimarySelectionOfferV1](object.client, message.ReadUint32()))
+	})
+}
+
+// ZwpPrimarySelectionOfferV1 is the zwp_primary_selection_offer_v1 interface: offer to transfer primary selection
+// contents
+type ZwpPrimarySelectionOfferV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPrimarySelectionOfferV1) Interface() string {
+	return "zwp_primary_selection_offer_v1"
+}
+
+// Receive sends the receive request: request that the primary selection contents are sent
+func (object ZwpPrimarySelectionOfferV1) Receive(mimeType string, fd int) error {
+	if err := Object(object).checkRequest("receive", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, mimeType).WithFds(fd))
+}
+
+// Destroy sends the destroy request: destroy the primary selection offer
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPrimarySelectionOfferV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// OnOffer registers a listener for the offer event: advertise offered mime type
+func (object ZwpPrimarySelectionOfferV1) OnOffer(listener func(mimeType string)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// ZwpPrimarySelectionSourceV1 is the zwp_primary_selection_source_v1 interface: offer to replace the contents of the
+// primary selection
+type ZwpPrimarySelectionSourceV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpPrimarySelectionSourceV1) Interface() string {
+	return "zwp_primary_selection_source_v1"
+}
+
+// Offer sends the offer request: add an offered mime type
+func (object ZwpPrimarySelectionSourceV1) Offer(mimeType string) error {
+	if err := Object(object).checkRequest("offer", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 0, mimeType))
+}
+
+// Destroy sends the destroy request: destroy the primary selection source
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpPrimarySelectionSourceV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 1))
+}
+
+// OnSend registers a listener for the send event: send the primary selection contents
+func (object ZwpPrimarySelectionSourceV1) OnSend(listener func(mimeType string, fd int)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadString(), message.ReadFd())
+	})
+}
+
+// OnCancelled registers a listener for the cancelled event: request for primary selection contents was canceled
+func (object ZwpPrimarySelectionSourceV1) OnCancelled(listener func()) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwpRelativePointerManagerV1 is the zwp_relative_pointer_manager_v1 interface: get relative pointer objects
+type ZwpRelativePointerManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpRelativePointerManagerV1) Interface() string {
+	return "zwp_relative_pointer_manager_v1"
+}
+
+// Destroy sends the destroy request: destroy the relative pointer manager object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpRelativePointerManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetRelativePointer sends the get_relative_pointer request: get a relative pointer object
+func (object ZwpRelativePointerManagerV1) GetRelativePointer(pointer WlPointer) (ZwpRelativePointerV1, error) {
+	if err := Object(object).checkRequest("get_relative_pointer", 1); err != nil {
+		return ZwpRelativePointerV1{}, err
+	}
+
+	id := ZwpRelativePointerV1(object.client.newObject("zwp_relative_pointer_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, pointer.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpRelativePointerV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZwpRelativePointerV1 is the zwp_relative_pointer_v1 interface: relative pointer object
+type ZwpRelativePointerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpRelativePointerV1) Interface() string {
+	return "zwp_relative_pointer_v1"
+}
+
+// Destroy sends the destroy request: release the relative pointer object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpRelativePointerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnRelativeMotion registers a listener for the relative_motion event: relative pointer motion
+//
+// Arguments:
+//   - utimeHi: high 32 bits of a 64 bit timestamp with microsecond granularity
+//   - utimeLo: low 32 bits of a 64 bit timestamp with microsecond granularity
+//   - dx: the x component of the motion vector
+//   - dy: the y component of the motion vector
+//   - dxUnaccel: the x component of the unaccelerated motion vector
+//   - dyUnaccel: the y component of the unaccelerated motion vector
+func (object ZwpRelativePointerV1) OnRelativeMotion(listener func(utimeHi uint32, utimeLo uint32, dx wayland.Fixed, dy wayland.Fixed, dxUnaccel wayland.Fixed, dyUnaccel wayland.Fixed)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadFixed(), message.ReadFixed(), message.ReadFixed(), message.ReadFixed())
+	})
+}
+
+// ZwpTextInputV3 is the zwp_text_input_v3 interface: text input
+type ZwpTextInputV3 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTextInputV3) Interface() string {
+	return "zwp_text_input_v3"
+}
+
+// ZwpTextInputV3ChangeCause is the change_cause enum of zwp_text_input_v3: text change reason
+type ZwpTextInputV3ChangeCause uint32
+
+const (
+	// input method caused the change
+	ZwpTextInputV3ChangeCauseInputMethod ZwpTextInputV3ChangeCause = 0
+	// something else than the input method caused the change
+	ZwpTextInputV3ChangeCauseOther ZwpTextInputV3ChangeCause = 1
+)
+
+func (value ZwpTextInputV3ChangeCause) String() string {
+	switch value {
+	case ZwpTextInputV3ChangeCauseInputMethod:
+		return "input_method"
+	case ZwpTextInputV3ChangeCauseOther:
+		return "other"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZwpTextInputV3ContentHint is the content_hint enum of zwp_text_input_v3: content hint
+type ZwpTextInputV3ContentHint uint32
+
+const (
+	// no special behavior
+	ZwpTextInputV3ContentHintNone ZwpTextInputV3ContentHint = 0x0
+	// suggest word completions
+	ZwpTextInputV3ContentHintCompletion ZwpTextInputV3ContentHint = 0x1
+	// suggest word corrections
+	ZwpTextInputV3ContentHintSpellcheck ZwpTextInputV3ContentHint = 0x2
+	// switch to uppercase letters at the start of a sentence
+	ZwpTextInputV3ContentHintAutoCapitalization ZwpTextInputV3ContentHint = 0x4
+	// prefer lowercase letters
+	ZwpTextInputV3ContentHintLowercase ZwpTextInputV3ContentHint = 0x8
+	// prefer uppercase letters
+	ZwpTextInputV3ContentHintUppercase ZwpTextInputV3ContentHint = 0x10
+	// prefer casing for titles and headings (can be language dependent)
+	ZwpTextInputV3ContentHintTitlecase ZwpTextInputV3ContentHint = 0x20
+	// characters should be hidden
+	ZwpTextInputV3ContentHintHiddenText ZwpTextInputV3ContentHint = 0x40
+	// typed text should not be stored
+	ZwpTextInputV3ContentHintSensitiveData ZwpTextInputV3ContentHint = 0x80
+	// just Latin characters should be entered
+	ZwpTextInputV3ContentHintLatin ZwpTextInputV3ContentHint = 0x100
+	// the text input is multiline
+	ZwpTextInputV3ContentHintMultiline ZwpTextInputV3ContentHint = 0x200
+)
+
+func (value ZwpTextInputV3ContentHint) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{0x0, "none"},
+		{0x1, "completion"},
+		{0x2, "spellcheck"},
+		{0x4, "auto_capitalization"},
+		{0x8, "lowercase"},
+		{0x10, "uppercase"},
+		{0x20, "titlecase"},
+		{0x40, "hidden_text"},
+		{0x80, "sensitive_data"},
+		{0x100, "latin"},
+		{0x200, "multiline"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value ZwpTextInputV3ContentHint) Has(flag ZwpTextInputV3ContentHint) bool {
+	return value&flag == flag
+}
+
+// ZwpTextInputV3ContentPurpose is the content_purpose enum of zwp_text_input_v3: content purpose
+type ZwpTextInputV3ContentPurpose uint32
+
+const (
+	// default input, allowing all characters
+	ZwpTextInputV3ContentPurposeNormal ZwpTextInputV3ContentPurpose = 0
+	// allow only alphabetic characters
+	ZwpTextInputV3ContentPurposeAlpha ZwpTextInputV3ContentPurpose = 1
+	// allow only digits
+	ZwpTextInputV3ContentPurposeDigits ZwpTextInputV3ContentPurpose = 2
+	// input a number (including decimal separator and sign)
+	ZwpTextInputV3ContentPurposeNumber ZwpTextInputV3ContentPurpose = 3
+	// input a phone number
+	ZwpTextInputV3ContentPurposePhone ZwpTextInputV3ContentPurpose = 4
+	// input an URL
+	ZwpTextInputV3ContentPurposeUrl ZwpTextInputV3ContentPurpose = 5
+	// input an email address
+	ZwpTextInputV3ContentPurposeEmail ZwpTextInputV3ContentPurpose = 6
+	// input a name of a person
+	ZwpTextInputV3ContentPurposeName ZwpTextInputV3ContentPurpose = 7
+	// input a password (combine with sensitive_data hint)
+	ZwpTextInputV3ContentPurposePassword ZwpTextInputV3ContentPurpose = 8
+	// input is a numeric password (combine with sensitive_data hint)
+	ZwpTextInputV3ContentPurposePin ZwpTextInputV3ContentPurpose = 9
+	// input a date
+	ZwpTextInputV3ContentPurposeDate ZwpTextInputV3ContentPurpose = 10
+	// input a time
+	ZwpTextInputV3ContentPurposeTime ZwpTextInputV3ContentPurpose = 11
+	// input a date and time
+	ZwpTextInputV3ContentPurposeDatetime ZwpTextInputV3ContentPurpose = 12
+	// input for a terminal
+	ZwpTextInputV3ContentPurposeTerminal ZwpTextInputV3ContentPurpose = 13
+)
+
+func (value ZwpTextInputV3ContentPurpose) String() string {
+	switch value {
+	case ZwpTextInputV3ContentPurposeNormal:
+		return "normal"
+	case ZwpTextInputV3ContentPurposeAlpha:
+		return "alpha"
+	case ZwpTextInputV3ContentPurposeDigits:
+		return "digits"
+	case ZwpTextInputV3ContentPurposeNumber:
+		return "number"
+	case ZwpTextInputV3ContentPurposePhone:
+		return "phone"
+	case ZwpTextInputV3ContentPurposeUrl:
+		return "url"
+	case ZwpTextInputV3ContentPurposeEmail:
+		return "email"
+	case ZwpTextInputV3ContentPurposeName:
+		return "name"
+	case ZwpTextInputV3ContentPurposePassword:
+		return "password"
+	case ZwpTextInputV3ContentPurposePin:
+		return "pin"
+	case ZwpTextInputV3ContentPurposeDate:
+		return "date"
+	case ZwpTextInputV3ContentPurposeTime:
+		return "time"
+	case ZwpTextInputV3ContentPurposeDatetime:
+		return "datetime"
+	case ZwpTextInputV3ContentPurposeTerminal:
+		return "terminal"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: Destroy the wp_text_input
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTextInputV3) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Enable sends the enable request: Request text input to be enabled
+func (object ZwpTextInputV3) Enable() error {
+	if err := Object(object).checkRequest("enable", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1))
+}
+
+// Disable sends the disable request: Disable text input on a surface
+func (object ZwpTextInputV3) Disable() error {
+	if err := Object(object).checkRequest("disable", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2))
+}
+
+// SetSurroundingText sends the set_surrounding_text request: sets the surrounding text
+func (object ZwpTextInputV3) SetSurroundingText(text string, cursor int32, anchor int32) error {
+	if err := Object(object).checkRequest("set_surrounding_text", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 3, text, cursor, anchor))
+}
+
+// SetTextChangeCause sends the set_text_change_cause request: indicates the cause of surrounding text change
+func (object ZwpTextInputV3) SetTextChangeCause(cause ZwpTextInputV3ChangeCause) error {
+	if err := Object(object).checkRequest("set_text_change_cause", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 4, uint32(cause)))
+}
+
+// SetContentType sends the set_content_type request: set content purpose and hint
+func (object ZwpTextInputV3) SetContentType(hint ZwpTextInputV3ContentHint, purpose ZwpTextInputV3ContentPurpose) error {
+	if err := Object(object).checkRequest("set_content_type", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 5, uint32(hint), uint32(purpose)))
+}
+
+// SetCursorRectangle sends the set_cursor_rectangle request: set cursor position
+func (object ZwpTextInputV3) SetCursorRectangle(x int32, y int32, width int32, height int32) error {
+	if err := Object(object).checkRequest("set_cursor_rectangle", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 6, x, y, width, height))
+}
+
+// Commit sends the commit request: commit state
+func (object ZwpTextInputV3) Commit() error {
+	if err := Object(object).checkRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 7))
+}
+
+// OnEnter registers a listener for the enter event: enter event
+func (object ZwpTextInputV3) OnEnter(listener func(surface WlSurface)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(WlSurface(object.client.resolve(message.ReadUint32())))
+	})
+}
+
+// OnLeave registers a listener for the leave event: leave event
+func (object ZwpTextInputV3) OnLeave(listener func(surface WlSurface)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(WlSurface(object.client.resolve(message.ReadUint32())))
+	})
+}
+
+// OnPreeditString registers a listener for the preedit_string event: pre-edit
+//
+// Arguments:
+//   - text: nil for null
+func (object ZwpTextInputV3) OnPreeditString(listener func(text *string, cursorBegin int32, cursorEnd int32)) chan struct{} {
+	return object.client.On(object.id, 2, func(message *wayland.Message) {
+		listener(message.ReadNullableString(), message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnCommitString registers a listener for the commit_string event: text commit
+//
+// Arguments:
+//   - text: nil for null
+func (object ZwpTextInputV3) OnCommitString(listener func(text *string)) chan struct{} {
+	return object.client.On(object.id, 3, func(message *wayland.Message) {
+		listener(message.ReadNullableString())
+	})
+}
+
+// OnDeleteSurroundingText registers a listener for the delete_surrounding_text event: delete surrounding text
+//
+// Arguments:
+//   - beforeLength: length of text before current cursor position
+//   - afterLength: length of text after current cursor position
+func (object ZwpTextInputV3) OnDeleteSurroundingText(listener func(beforeLength uint32, afterLength uint32)) chan struct{} {
+	return object.client.On(object.id, 4, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnDone registers a listener for the done event: apply changes
+func (object ZwpTextInputV3) OnDone(listener func(serial uint32)) chan struct{} {
+	return object.client.On(object.id, 5, func(message *wayland.Message) {
+		listener(message.ReadUint32())
+	})
+}
+
+// ZwpTextInputManagerV3 is the zwp_text_input_manager_v3 interface: text input manager
+type ZwpTextInputManagerV3 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwpTextInputManagerV3) Interface() string {
+	return "zwp_text_input_manager_v3"
+}
+
+// Destroy sends the destroy request: Destroy the wp_text_input_manager
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwpTextInputManagerV3) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetTextInput sends the get_text_input request: create a new text input object
+func (object ZwpTextInputManagerV3) GetTextInput(seat WlSeat) (ZwpTextInputV3, error) {
+	if err := Object(object).checkRequest("get_text_input", 1); err != nil {
+		return ZwpTextInputV3{}, err
+	}
+
+	id := ZwpTextInputV3(object.client.newObject("zwp_text_input_v3", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, seat.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZwpTextInputV3{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgDecorationManagerV1 is the zxdg_decoration_manager_v1 interface: window decoration manager
+//
+// This interface allows a compositor to announce support for server-side decorations.
+//
+// A client can use this protocol to request being decorated by a supporting compositor.
+type ZxdgDecorationManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgDecorationManagerV1) Interface() string {
+	return "zxdg_decoration_manager_v1"
+}
+
+// Destroy sends the destroy request: destroy the decoration manager object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgDecorationManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetToplevelDecoration sends the get_toplevel_decoration request: create a new toplevel decoration object
+func (object ZxdgDecorationManagerV1) GetToplevelDecoration(toplevel XdgToplevel) (ZxdgToplevelDecorationV1, error) {
+	if err := Object(object).checkRequest("get_toplevel_decoration", 1); err != nil {
+		return ZxdgToplevelDecorationV1{}, err
+	}
+
+	id := ZxdgToplevelDecorationV1(object.client.newObject("zxdg_toplevel_decoration_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, toplevel.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgToplevelDecorationV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgToplevelDecorationV1 is the zxdg_toplevel_decoration_v1 interface: decoration object for a toplevel surface
+type ZxdgToplevelDecorationV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgToplevelDecorationV1) Interface() string {
+	return "zxdg_toplevel_decoration_v1"
+}
+
+// ZxdgToplevelDecorationV1Error is the error enum of zxdg_toplevel_decoration_v1
+type ZxdgToplevelDecorationV1Error uint32
+
+const (
+	// xdg_toplevel has a buffer attached before configure
+	ZxdgToplevelDecorationV1ErrorUnconfiguredBuffer ZxdgToplevelDecorationV1Error = 0
+	// xdg_toplevel already has a decoration object
+	ZxdgToplevelDecorationV1ErrorAlreadyConstructed ZxdgToplevelDecorationV1Error = 1
+	// xdg_toplevel destroyed before the decoration object
+	ZxdgToplevelDecorationV1ErrorOrphaned ZxdgToplevelDecorationV1Error = 2
+	// invalid mode
+	ZxdgToplevelDecorationV1ErrorInvalidMode ZxdgToplevelDecorationV1Error = 3
+)
+
+func (value ZxdgToplevelDecorationV1Error) String() string {
+	switch value {
+	case ZxdgToplevelDecorationV1ErrorUnconfiguredBuffer:
+		return "unconfigured_buffer"
+	case ZxdgToplevelDecorationV1ErrorAlreadyConstructed:
+		return "already_constructed"
+	case ZxdgToplevelDecorationV1ErrorOrphaned:
+		return "orphaned"
+	case ZxdgToplevelDecorationV1ErrorInvalidMode:
+		return "invalid_mode"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZxdgToplevelDecorationV1Mode is the mode enum of zxdg_toplevel_decoration_v1: window decoration modes
+type ZxdgToplevelDecorationV1Mode uint32
+
+const (
+	// no server-side window decoration
+	ZxdgToplevelDecorationV1ModeClientSide ZxdgToplevelDecorationV1Mode = 1
+	// server-side window decoration
+	ZxdgToplevelDecorationV1ModeServerSide ZxdgToplevelDecorationV1Mode = 2
+)
+
+func (value ZxdgToplevelDecorationV1Mode) String() string {
+	switch value {
+	case ZxdgToplevelDecorationV1ModeClientSide:
+		return "client_side"
+	case ZxdgToplevelDecorationV1ModeServerSide:
+		return "server_side"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Destroy sends the destroy request: destroy the decoration object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgToplevelDecorationV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetMode sends the set_mode request: set the decoration mode
+//
+// Arguments:
+//   - mode: the decoration mode
+func (object ZxdgToplevelDecorationV1) SetMode(mode ZxdgToplevelDecorationV1Mode) error {
+	if err := Object(object).checkRequest("set_mode", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, uint32(mode)))
+}
+
+// UnsetMode sends the unset_mode request: unset the decoration mode
+func (object ZxdgToplevelDecorationV1) UnsetMode() error {
+	if err := Object(object).checkRequest("unset_mode", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 2))
+}
+
+// OnConfigure registers a listener for the configure event: notify a decoration mode change
+//
+// Arguments:
+//   - mode: the decoration mode
+func (object ZxdgToplevelDecorationV1) OnConfigure(listener func(mode ZxdgToplevelDecorationV1Mode)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(ZxdgToplevelDecorationV1Mode(message.ReadUint32()))
+	})
+}
+
+// ZxdgExporterV1 is the zxdg_exporter_v1 interface: interface for exporting surfaces
+type ZxdgExporterV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgExporterV1) Interface() string {
+	return "zxdg_exporter_v1"
+}
+
+// Destroy sends the destroy request: destroy the xdg_exporter object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgExporterV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Export sends the export request: export a toplevel surface
+//
+// Arguments:
+//   - id: the new xdg_exported object
+//   - surface: the surface to export
+func (object ZxdgExporterV1) Export(surface WlSurface) (ZxdgExportedV1, error) {
+	if err := Object(object).checkRequest("export", 1); err != nil {
+		return ZxdgExportedV1{}, err
+	}
+
+	id := ZxdgExportedV1(object.client.newObject("zxdg_exported_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgExportedV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgImporterV1 is the zxdg_importer_v1 interface: interface for importing surfaces
+type ZxdgImporterV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgImporterV1) Interface() string {
+	return "zxdg_importer_v1"
+}
+
+// Destroy sends the destroy request: destroy the xdg_importer object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgImporterV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// Import sends the import request: import a toplevel surface
+//
+// Arguments:
+//   - id: the new xdg_imported object
+//   - handle: the exported surface handle
+func (object ZxdgImporterV1) Import(handle string) (ZxdgImportedV1, error) {
+	if err := Object(object).checkRequest("import", 1); err != nil {
+		return ZxdgImportedV1{}, err
+	}
+
+	id := ZxdgImportedV1(object.client.newObject("zxdg_imported_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, handle)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgImportedV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgExportedV1 is the zxdg_exported_v1 interface: an exported surface handle
+type ZxdgExportedV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgExportedV1) Interface() string {
+	return "zxdg_exported_v1"
+}
+
+// Destroy sends the destroy request: unexport the exported surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgExportedV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnHandle registers a listener for the handle event: the exported surface handle
+//
+// Arguments:
+//   - handle: the exported surface handle
+func (object ZxdgExportedV1) OnHandle(listener func(handle string)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// ZxdgImportedV1 is the zxdg_imported_v1 interface: an imported surface handle
+type ZxdgImportedV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgImportedV1) Interface() string {
+	return "zxdg_imported_v1"
+}
+
+// Destroy sends the destroy request: destroy the xdg_imported object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgImportedV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetParentOf sends the set_parent_of request: set as the parent of some surface
+//
+// Arguments:
+//   - surface: the child surface
+func (object ZxdgImportedV1) SetParentOf(surface WlSurface) error {
+	if err := Object(object).checkRequest("set_parent_of", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, surface.id))
+}
+
+// OnDestroyed registers a listener for the destroyed event: the imported surface handle has been destroyed
+func (object ZxdgImportedV1) OnDestroyed(listener func()) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZxdgExporterV2 is the zxdg_exporter_v2 interface: interface for exporting surfaces
+type ZxdgExporterV2 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgExporterV2) Interface() string {
+	return "zxdg_exporter_v2"
+}
+
+// Destroy sends the destroy request: destroy the xdg_exporter object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgExporterV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// ExportToplevel sends the export_toplevel request: export a toplevel surface
+//
+// Arguments:
+//   - id: the new xdg_exported object
+//   - surface: the surface to export
+func (object ZxdgExporterV2) ExportToplevel(surface WlSurface) (ZxdgExportedV2, error) {
+	if err := Object(object).checkRequest("export_toplevel", 1); err != nil {
+		return ZxdgExportedV2{}, err
+	}
+
+	id := ZxdgExportedV2(object.client.newObject("zxdg_exported_v2", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, surface.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgExportedV2{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgImporterV2 is the zxdg_importer_v2 interface: interface for importing surfaces
+type ZxdgImporterV2 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgImporterV2) Interface() string {
+	return "zxdg_importer_v2"
+}
+
+// Destroy sends the destroy request: destroy the xdg_importer object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgImporterV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// ImportToplevel sends the import_toplevel request: import a toplevel surface
+//
+// Arguments:
+//   - id: the new xdg_imported object
+//   - handle: the exported surface handle
+func (object ZxdgImporterV2) ImportToplevel(handle string) (ZxdgImportedV2, error) {
+	if err := Object(object).checkRequest("import_toplevel", 1); err != nil {
+		return ZxdgImportedV2{}, err
+	}
+
+	id := ZxdgImportedV2(object.client.newObject("zxdg_imported_v2", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, handle)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgImportedV2{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgExportedV2 is the zxdg_exported_v2 interface: an exported surface handle
+type ZxdgExportedV2 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgExportedV2) Interface() string {
+	return "zxdg_exported_v2"
+}
+
+// Destroy sends the destroy request: unexport the exported surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgExportedV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnHandle registers a listener for the handle event: the exported surface handle
+//
+// Arguments:
+//   - handle: the exported surface handle
+func (object ZxdgExportedV2) OnHandle(listener func(handle string)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// ZxdgImportedV2 is the zxdg_imported_v2 interface: an imported surface handle
+type ZxdgImportedV2 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgImportedV2) Interface() string {
+	return "zxdg_imported_v2"
+}
+
+// Destroy sends the destroy request: destroy the xdg_imported object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgImportedV2) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// SetParentOf sends the set_parent_of request: set as the parent of some surface
+//
+// Arguments:
+//   - surface: the child surface
+func (object ZxdgImportedV2) SetParentOf(surface WlSurface) error {
+	if err := Object(object).checkRequest("set_parent_of", 1); err != nil {
+		return err
+	}
+
+	return object.client.Write(wayland.NewMessage(object.id, 1, surface.id))
+}
+
+// OnDestroyed registers a listener for the destroyed event: the imported surface handle has been destroyed
+func (object ZxdgImportedV2) OnDestroyed(listener func()) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZxdgOutputManagerV1 is the zxdg_output_manager_v1 interface: manage xdg_output objects
+type ZxdgOutputManagerV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgOutputManagerV1) Interface() string {
+	return "zxdg_output_manager_v1"
+}
+
+// Destroy sends the destroy request: destroy the xdg_output_manager object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgOutputManagerV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// GetXdgOutput sends the get_xdg_output request: create an xdg output from a wl_output
+func (object ZxdgOutputManagerV1) GetXdgOutput(output WlOutput) (ZxdgOutputV1, error) {
+	if err := Object(object).checkRequest("get_xdg_output", 1); err != nil {
+		return ZxdgOutputV1{}, err
+	}
+
+	id := ZxdgOutputV1(object.client.newObject("zxdg_output_v1", object.version))
+
+	if err := object.client.Write(wayland.NewMessage(object.id, 1, id.id, output.id)); err != nil {
+		object.client.FreeObjectId(id.id)
+		return ZxdgOutputV1{}, err
+	}
+
+	return id, nil
+}
+
+// ZxdgOutputV1 is the zxdg_output_v1 interface: compositor logical output region
+type ZxdgOutputV1 Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZxdgOutputV1) Interface() string {
+	return "zxdg_output_v1"
+}
+
+// Destroy sends the destroy request: destroy the xdg_output object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZxdgOutputV1) Destroy() error {
+	if err := Object(object).checkRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return object.client.writeDestructor(object.id, wayland.NewMessage(object.id, 0))
+}
+
+// OnLogicalPosition registers a listener for the logical_position event: position of the output within the global
+// compositor space
+//
+// Arguments:
+//   - x: x position within the global compositor space
+//   - y: y position within the global compositor space
+func (object ZxdgOutputV1) OnLogicalPosition(listener func(x int32, y int32)) chan struct{} {
+	return object.client.On(object.id, 0, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnLogicalSize registers a listener for the logical_size event: size of the output in the global compositor space
+//
+// Arguments:
+//   - width: width in global compositor space
+//   - height: height in global compositor space
+func (object ZxdgOutputV1) OnLogicalSize(listener func(width int32, height int32)) chan struct{} {
+	return object.client.On(object.id, 1, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnDone registers a listener for the done event: all information about the output have been sent
+func (object ZxdgOutputV1) OnDone(listener func()) chan struct{} {
+	return object.client.On(object.id, 2, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnName registers a listener for the name event: name of this output
+//
+// Arguments:
+//   - name: output name
+//
+// Available since version 2 of zxdg_output_v1.
+func (object ZxdgOutputV1) OnName(listener func(name string)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("name", 2))
+	return object.client.On(object.id, 3, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnDescription registers a listener for the description event: human-readable description of this output
+//
+// Arguments:
+//   - description: output description
+//
+// Available since version 2 of zxdg_output_v1.
+func (object ZxdgOutputV1) OnDescription(listener func(description string)) chan struct{} {
+	object.client.unsupported(Object(object).checkVersion("description", 2))
+	return object.client.On(object.id, 4, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+var interfaces = map[string]*wayland.Interface{
+	"wl_display": {
+		Name: "wl_display",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "sync", Signature: "n", NewInterfaces: []string{"wl_callback"}},
+			{Name: "get_registry", Signature: "n", NewInterfaces: []string{"wl_registry"}},
+		},
+		Events: []wayland.Method{
+			{Name: "error", Signature: "ous"},
+			{Name: "delete_id", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_object", Value: 0},
+					{Name: "invalid_method", Value: 1},
+					{Name: "no_memory", Value: 2},
+					{Name: "implementation", Value: 3},
+				},
+			},
+		},
+	},
+	"wl_registry": {
+		Name: "wl_registry",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "bind", Signature: "usun", NewInterfaces: []string{""}},
+		},
+		Events: []wayland.Method{
+			{Name: "global", Signature: "usu"},
+			{Name: "global_remove", Signature: "u"},
+		},
+	},
+	"wl_callback": {
+		Name: "wl_callback",
+		Version: 1,
+		Events: []wayland.Method{
+			{Name: "done", Signature: "u", Destructor: true},
+		},
+	},
+	"wl_compositor": {
+		Name: "wl_compositor",
+		Version: 6,
+		Requests: []wayland.Method{
+			{Name: "create_surface", Signature: "n", NewInterfaces: []string{"wl_surface"}},
+			{Name: "create_region", Signature: "n", NewInterfaces: []string{"wl_region"}},
+		},
+	},
+	"wl_shm_pool": {
+		Name: "wl_shm_pool",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "create_buffer", Signature: "niiiiu", NewInterfaces: []string{"wl_buffer"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "resize", Signature: "i"},
+		},
+	},
+	"wl_shm": {
+		Name: "wl_shm",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "create_pool", Signature: "nhi", NewInterfaces: []string{"wl_shm_pool"}},
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "format", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_format", Value: 0},
+					{Name: "invalid_stride", Value: 1},
+					{Name: "invalid_fd", Value: 2},
+				},
+			},
+			{
+				Name: "format",
+				Entries: []wayland.EnumEntry{
+					{Name: "argb8888", Value: 0},
+					{Name: "xrgb8888", Value: 1},
+					{Name: "c8", Value: 0x20203843},
+					{Name: "rgb332", Value: 0x38424752},
+					{Name: "bgr233", Value: 0x38524742},
+					{Name: "xrgb4444", Value: 0x32315258},
+					{Name: "xbgr4444", Value: 0x32314258},
+					{Name: "rgbx4444", Value: 0x32315852},
+					{Name: "bgrx4444", Value: 0x32315842},
+					{Name: "argb4444", Value: 0x32315241},
+					{Name: "abgr4444", Value: 0x32314241},
+					{Name: "rgba4444", Value: 0x32314152},
+					{Name: "bgra4444", Value: 0x32314142},
+					{Name: "xrgb1555", Value: 0x35315258},
+					{Name: "xbgr1555", Value: 0x35314258},
+					{Name: "rgbx5551", Value: 0x35315852},
+					{Name: "bgrx5551", Value: 0x35315842},
+					{Name: "argb1555", Value: 0x35315241},
+					{Name: "abgr1555", Value: 0x35314241},
+					{Name: "rgba5551", Value: 0x35314152},
+					{Name: "bgra5551", Value: 0x35314142},
+					{Name: "rgb565", Value: 0x36314752},
+					{Name: "bgr565", Value: 0x36314742},
+					{Name: "rgb888", Value: 0x34324752},
+					{Name: "bgr888", Value: 0x34324742},
+					{Name: "xbgr8888", Value: 0x34324258},
+					{Name: "rgbx8888", Value: 0x34325852},
+					{Name: "bgrx8888", Value: 0x34325842},
+					{Name: "abgr8888", Value: 0x34324241},
+					{Name: "rgba8888", Value: 0x34324152},
+					{Name: "bgra8888", Value: 0x34324142},
+					{Name: "xrgb2101010", Value: 0x30335258},
+					{Name: "xbgr2101010", Value: 0x30334258},
+					{Name: "rgbx1010102", Value: 0x30335852},
+					{Name: "bgrx1010102", Value: 0x30335842},
+					{Name: "argb2101010", Value: 0x30335241},
+					{Name: "abgr2101010", Value: 0x30334241},
+					{Name: "rgba1010102", Value: 0x30334152},
+					{Name: "bgra1010102", Value: 0x30334142},
+					{Name: "yuyv", Value: 0x56595559},
+					{Name: "yvyu", Value: 0x55595659},
+					{Name: "uyvy", Value: 0x59565955},
+					{Name: "vyuy", Value: 0x59555956},
+					{Name: "ayuv", Value: 0x56555941},
+					{Name: "nv12", Value: 0x3231564e},
+					{Name: "nv21", Value: 0x3132564e},
+					{Name: "nv16", Value: 0x3631564e},
+					{Name: "nv61", Value: 0x3136564e},
+					{Name: "yuv410", Value: 0x39565559},
+					{Name: "yvu410", Value: 0x39555659},
+					{Name: "yuv411", Value: 0x31315559},
+					{Name: "yvu411", Value: 0x31315659},
+					{Name: "yuv420", Value: 0x32315559},
+					{Name: "yvu420", Value: 0x32315659},
+					{Name: "yuv422", Value: 0x36315559},
+					{Name: "yvu422", Value: 0x36315659},
+					{Name: "yuv444", Value: 0x34325559},
+					{Name: "yvu444", Value: 0x34325659},
+				},
+			},
+		},
+	},
+	"wl_buffer": {
+		Name: "wl_buffer",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "release", Signature: ""},
+		},
+	},
+	"wl_data_offer": {
+		Name: "wl_data_offer",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "accept", Signature: "u?s"},
+			{Name: "receive", Signature: "sh"},
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "finish", Signature: ""},
+			{Name: "set_actions", Signature: "uu"},
+		},
+		Events: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+			{Name: "source_actions", Signature: "u"},
+			{Name: "action", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_finish", Value: 0},
+					{Name: "invalid_action_mask", Value: 1},
+					{Name: "invalid_action", Value: 2},
+					{Name: "invalid_offer", Value: 3},
+				},
+			},
+		},
+	},
+	"wl_data_source": {
+		Name: "wl_data_source",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_actions", Signature: "u"},
+		},
+		Events: []wayland.Method{
+			{Name: "target", Signature: "?s"},
+			{Name: "send", Signature: "sh"},
+			{Name: "cancelled", Signature: ""},
+			{Name: "dnd_drop_performed", Signature: ""},
+			{Name: "dnd_finished", Signature: ""},
+			{Name: "action", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_action_mask", Value: 0},
+					{Name: "invalid_source", Value: 1},
+				},
+			},
+		},
+	},
+	"wl_data_device": {
+		Name: "wl_data_device",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "start_drag", Signature: "?oo?ou"},
+			{Name: "set_selection", Signature: "?ou"},
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "data_offer", Signature: "n", NewInterfaces: []string{"wl_data_offer"}},
+			{Name: "enter", Signature: "uoff?o"},
+			{Name: "leave", Signature: ""},
+			{Name: "motion", Signature: "uff"},
+			{Name: "drop", Signature: ""},
+			{Name: "selection", Signature: "?o"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "role", Value: 0},
+					{Name: "used_source", Value: 1},
+				},
+			},
+		},
+	},
+	"wl_data_device_manager": {
+		Name: "wl_data_device_manager",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "create_data_source", Signature: "n", NewInterfaces: []string{"wl_data_source"}},
+			{Name: "get_data_device", Signature: "no", NewInterfaces: []string{"wl_data_device"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "dnd_action",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "copy", Value: 1},
+					{Name: "move", Value: 2},
+					{Name: "ask", Value: 4},
+				},
+			},
+		},
+	},
+	"wl_shell": {
+		Name: "wl_shell",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "get_shell_surface", Signature: "no", NewInterfaces: []string{"wl_shell_surface"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "role", Value: 0},
+				},
+			},
+		},
+	},
+	"wl_shell_surface": {
+		Name: "wl_shell_surface",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "pong", Signature: "u"},
+			{Name: "move", Signature: "ou"},
+			{Name: "resize", Signature: "ouu"},
+			{Name: "set_toplevel", Signature: ""},
+			{Name: "set_transient", Signature: "oiiu"},
+			{Name: "set_fullscreen", Signature: "uu?o"},
+			{Name: "set_popup", Signature: "ouoiiu"},
+			{Name: "set_maximized", Signature: "?o"},
+			{Name: "set_title", Signature: "s"},
+			{Name: "set_class", Signature: "s"},
+		},
+		Events: []wayland.Method{
+			{Name: "ping", Signature: "u"},
+			{Name: "configure", Signature: "uii"},
+			{Name: "popup_done", Signature: ""},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "resize",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "top", Value: 1},
+					{Name: "bottom", Value: 2},
+					{Name: "left", Value: 4},
+					{Name: "top_left", Value: 5},
+					{Name: "bottom_left", Value: 6},
+					{Name: "right", Value: 8},
+					{Name: "top_right", Value: 9},
+					{Name: "bottom_right", Value: 10},
+				},
+			},
+			{
+				Name: "transient",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "inactive", Value: 0x1},
+				},
+			},
+			{
+				Name: "fullscreen_method",
+				Entries: []wayland.EnumEntry{
+					{Name: "default", Value: 0},
+					{Name: "scale", Value: 1},
+					{Name: "driver", Value: 2},
+					{Name: "fill", Value: 3},
+				},
+			},
+		},
+	},
+	"wl_surface": {
+		Name: "wl_surface",
+		Version: 6,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "attach", Signature: "?oii"},
+			{Name: "damage", Signature: "iiii"},
+			{Name: "frame", Signature: "n", NewInterfaces: []string{"wl_callback"}},
+			{Name: "set_opaque_region", Signature: "?o"},
+			{Name: "set_input_region", Signature: "?o"},
+			{Name: "commit", Signature: ""},
+			{Name: "set_buffer_transform", Signature: "i"},
+			{Name: "set_buffer_scale", Signature: "i"},
+			{Name: "damage_buffer", Signature: "iiii"},
+			{Name: "offset", Signature: "ii"},
+		},
+		Events: []wayland.Method{
+			{Name: "enter", Signature: "o"},
+			{Name: "leave", Signature: "o"},
+			{Name: "preferred_buffer_scale", Signature: "i"},
+			{Name: "preferred_buffer_transform", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_scale", Value: 0},
+					{Name: "invalid_transform", Value: 1},
+					{Name: "invalid_size", Value: 2},
+					{Name: "invalid_offset", Value: 3},
+					{Name: "defunct_role_object", Value: 4},
+				},
+			},
+		},
+	},
+	"wl_seat": {
+		Name: "wl_seat",
+		Version: 10,
+		Requests: []wayland.Method{
+			{Name: "get_pointer", Signature: "n", NewInterfaces: []string{"wl_pointer"}},
+			{Name: "get_keyboard", Signature: "n", NewInterfaces: []string{"wl_keyboard"}},
+			{Name: "get_touch", Signature: "n", NewInterfaces: []string{"wl_touch"}},
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "capabilities", Signature: "u"},
+			{Name: "name", Signature: "s"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "capability",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "pointer", Value: 1},
+					{Name: "keyboard", Value: 2},
+					{Name: "touch", Value: 4},
+				},
+			},
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "missing_capability", Value: 0},
+				},
+			},
+		},
+	},
+	"wl_pointer": {
+		Name: "wl_pointer",
+		Version: 10,
+		Requests: []wayland.Method{
+			{Name: "set_cursor", Signature: "u?oii"},
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "enter", Signature: "uoff"},
+			{Name: "leave", Signature: "uo"},
+			{Name: "motion", Signature: "uff"},
+			{Name: "button", Signature: "uuuu"},
+			{Name: "axis", Signature: "uuf"},
+			{Name: "frame", Signature: ""},
+			{Name: "axis_source", Signature: "u"},
+			{Name: "axis_stop", Signature: "uu"},
+			{Name: "axis_discrete", Signature: "ui"},
+			{Name: "axis_value120", Signature: "ui"},
+			{Name: "axis_relative_direction", Signature: "uu"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "role", Value: 0},
+				},
+			},
+			{
+				Name: "button_state",
+				Entries: []wayland.EnumEntry{
+					{Name: "released", Value: 0},
+					{Name: "pressed", Value: 1},
+				},
+			},
+			{
+				Name: "axis",
+				Entries: []wayland.EnumEntry{
+					{Name: "vertical_scroll", Value: 0},
+					{Name: "horizontal_scroll", Value: 1},
+				},
+			},
+			{
+				Name: "axis_source",
+				Entries: []wayland.EnumEntry{
+					{Name: "wheel", Value: 0},
+					{Name: "finger", Value: 1},
+					{Name: "continuous", Value: 2},
+					{Name: "wheel_tilt", Value: 3},
+				},
+			},
+			{
+				Name: "axis_relative_direction",
+				Entries: []wayland.EnumEntry{
+					{Name: "identical", Value: 0},
+					{Name: "inverted", Value: 1},
+				},
+			},
+		},
+	},
+	"wl_keyboard": {
+		Name: "wl_keyboard",
+		Version: 10,
+		Requests: []wayland.Method{
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "keymap", Signature: "uhu"},
+			{Name: "enter", Signature: "uoa"},
+			{Name: "leave", Signature: "uo"},
+			{Name: "key", Signature: "uuuu"},
+			{Name: "modifiers", Signature: "uuuuu"},
+			{Name: "repeat_info", Signature: "ii"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "keymap_format",
+				Entries: []wayland.EnumEntry{
+					{Name: "no_keymap", Value: 0},
+					{Name: "xkb_v1", Value: 1},
+				},
+			},
+			{
+				Name: "key_state",
+				Entries: []wayland.EnumEntry{
+					{Name: "released", Value: 0},
+					{Name: "pressed", Value: 1},
+					{Name: "repeated", Value: 2},
+				},
+			},
+		},
+	},
+	"wl_touch": {
+		Name: "wl_touch",
+		Version: 10,
+		Requests: []wayland.Method{
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "down", Signature: "uuoiff"},
+			{Name: "up", Signature: "uui"},
+			{Name: "motion", Signature: "uiff"},
+			{Name: "frame", Signature: ""},
+			{Name: "cancel", Signature: ""},
+			{Name: "shape", Signature: "iff"},
+			{Name: "orientation", Signature: "if"},
+		},
+	},
+	"wl_output": {
+		Name: "wl_output",
+		Version: 4,
+		Requests: []wayland.Method{
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "geometry", Signature: "iiiiissi"},
+			{Name: "mode", Signature: "uiii"},
+			{Name: "done", Signature: ""},
+			{Name: "scale", Signature: "i"},
+			{Name: "name", Signature: "s"},
+			{Name: "description", Signature: "s"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "subpixel",
+				Entries: []wayland.EnumEntry{
+					{Name: "unknown", Value: 0},
+					{Name: "none", Value: 1},
+					{Name: "horizontal_rgb", Value: 2},
+					{Name: "horizontal_bgr", Value: 3},
+					{Name: "vertical_rgb", Value: 4},
+					{Name: "vertical_bgr", Value: 5},
+				},
+			},
+			{
+				Name: "transform",
+				Entries: []wayland.EnumEntry{
+					{Name: "normal", Value: 0},
+					{Name: "90", Value: 1},
+					{Name: "180", Value: 2},
+					{Name: "270", Value: 3},
+					{Name: "flipped", Value: 4},
+					{Name: "flipped_90", Value: 5},
+					{Name: "flipped_180", Value: 6},
+					{Name: "flipped_270", Value: 7},
+				},
+			},
+			{
+				Name: "mode",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "current", Value: 0x1},
+					{Name: "preferred", Value: 0x2},
+				},
+			},
+		},
+	},
+	"wl_region": {
+		Name: "wl_region",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "add", Signature: "iiii"},
+			{Name: "subtract", Signature: "iiii"},
+		},
+	},
+	"wl_subcompositor": {
+		Name: "wl_subcompositor",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_subsurface", Signature: "noo", NewInterfaces: []string{"wl_subsurface"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "bad_surface", Value: 0},
+					{Name: "bad_parent", Value: 1},
+				},
+			},
+		},
+	},
+	"wl_subsurface": {
+		Name: "wl_subsurface",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_position", Signature: "ii"},
+			{Name: "place_above", Signature: "o"},
+			{Name: "place_below", Signature: "o"},
+			{Name: "set_sync", Signature: ""},
+			{Name: "set_desync", Signature: ""},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "bad_surface", Value: 0},
+				},
+			},
+		},
+	},
+	"wl_fixes": {
+		Name: "wl_fixes",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "destroy_registry", Signature: "o"},
+		},
+	},
+	"zwp_linux_dmabuf_v1": {
+		Name: "zwp_linux_dmabuf_v1",
+		Version: 5,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_params", Signature: "n", NewInterfaces: []string{"zwp_linux_buffer_params_v1"}},
+			{Name: "get_default_feedback", Signature: "n", NewInterfaces: []string{"zwp_linux_dmabuf_feedback_v1"}},
+			{Name: "get_surface_feedback", Signature: "no", NewInterfaces: []string{"zwp_linux_dmabuf_feedback_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "format", Signature: "u"},
+			{Name: "modifier", Signature: "uuu"},
+		},
+	},
+	"zwp_linux_buffer_params_v1": {
+		Name: "zwp_linux_buffer_params_v1",
+		Version: 5,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "add", Signature: "huuuuu"},
+			{Name: "create", Signature: "iiuu"},
+			{Name: "create_immed", Signature: "niiuu", NewInterfaces: []string{"wl_buffer"}},
+		},
+		Events: []wayland.Method{
+			{Name: "created", Signature: "n", NewInterfaces: []string{"wl_buffer"}},
+			{Name: "failed", Signature: ""},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "already_used", Value: 0},
+					{Name: "plane_idx", Value: 1},
+					{Name: "plane_set", Value: 2},
+					{Name: "incomplete", Value: 3},
+					{Name: "invalid_format", Value: 4},
+					{Name: "invalid_dimensions", Value: 5},
+					{Name: "out_of_bounds", Value: 6},
+					{Name: "invalid_wl_buffer", Value: 7},
+				},
+			},
+			{
+				Name: "flags",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "y_invert", Value: 1},
+					{Name: "interlaced", Value: 2},
+					{Name: "bottom_first", Value: 4},
+				},
+			},
+		},
+	},
+	"zwp_linux_dmabuf_feedback_v1": {
+		Name: "zwp_linux_dmabuf_feedback_v1",
+		Version: 5,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "done", Signature: ""},
+			{Name: "format_table", Signature: "hu"},
+			{Name: "main_device", Signature: "a"},
+			{Name: "tranche_done", Signature: ""},
+			{Name: "tranche_target_device", Signature: "a"},
+			{Name: "tranche_formats", Signature: "a"},
+			{Name: "tranche_flags", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "tranche_flags",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "scanout", Value: 1},
+				},
+			},
+		},
+	},
+	"wp_presentation": {
+		Name: "wp_presentation",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "feedback", Signature: "on", NewInterfaces: []string{"wp_presentation_feedback"}},
+		},
+		Events: []wayland.Method{
+			{Name: "clock_id", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_timestamp", Value: 0},
+					{Name: "invalid_flag", Value: 1},
+				},
+			},
+		},
+	},
+	"wp_presentation_feedback": {
+		Name: "wp_presentation_feedback",
+		Version: 2,
+		Events: []wayland.Method{
+			{Name: "sync_output", Signature: "o"},
+			{Name: "presented", Signature: "uuuuuuu", Destructor: true},
+			{Name: "discarded", Signature: "", Destructor: true},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "kind",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "vsync", Value: 0x1},
+					{Name: "hw_clock", Value: 0x2},
+					{Name: "hw_completion", Value: 0x4},
+					{Name: "zero_copy", Value: 0x8},
+				},
+			},
+		},
+	},
+	"zwp_tablet_manager_v2": {
+		Name: "zwp_tablet_manager_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "get_tablet_seat", Signature: "no", NewInterfaces: []string{"zwp_tablet_seat_v2"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"zwp_tablet_seat_v2": {
+		Name: "zwp_tablet_seat_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "tablet_added", Signature: "n", NewInterfaces: []string{"zwp_tablet_v2"}},
+			{Name: "tool_added", Signature: "n", NewInterfaces: []string{"zwp_tablet_tool_v2"}},
+			{Name: "pad_added", Signature: "n", NewInterfaces: []string{"zwp_tablet_pad_v2"}},
+		},
+	},
+	"zwp_tablet_tool_v2": {
+		Name: "zwp_tablet_tool_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "set_cursor", Signature: "u?oii"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "type", Signature: "u"},
+			{Name: "hardware_serial", Signature: "uu"},
+			{Name: "hardware_id_wacom", Signature: "uu"},
+			{Name: "capability", Signature: "u"},
+			{Name: "done", Signature: ""},
+			{Name: "removed", Signature: ""},
+			{Name: "proximity_in", Signature: "uoo"},
+			{Name: "proximity_out", Signature: ""},
+			{Name: "down", Signature: "u"},
+			{Name: "up", Signature: ""},
+			{Name: "motion", Signature: "ff"},
+			{Name: "pressure", Signature: "u"},
+			{Name: "distance", Signature: "u"},
+			{Name: "tilt", Signature: "ff"},
+			{Name: "rotation", Signature: "f"},
+			{Name: "slider", Signature: "i"},
+			{Name: "wheel", Signature: "fi"},
+			{Name: "button", Signature: "uuu"},
+			{Name: "frame", Signature: "u"},
+		},
+	},
+	"zwp_tablet_v2": {
+		Name: "zwp_tablet_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "name", Signature: "s"},
+			{Name: "id", Signature: "uu"},
+			{Name: "path", Signature: "s"},
+			{Name: "done", Signature: ""},
+			{Name: "removed", Signature: ""},
+			{Name: "bustype", Signature: "u"},
+		},
+	},
+	"zwp_tablet_pad_ring_v2": {
+		Name: "zwp_tablet_pad_ring_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "set_feedback", Signature: "su"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "source", Signature: "u"},
+			{Name: "angle", Signature: "f"},
+			{Name: "stop", Signature: ""},
+			{Name: "frame", Signature: "u"},
+		},
+	},
+	"zwp_tablet_pad_strip_v2": {
+		Name: "zwp_tablet_pad_strip_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "set_feedback", Signature: "su"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "source", Signature: "u"},
+			{Name: "position", Signature: "u"},
+			{Name: "stop", Signature: ""},
+			{Name: "frame", Signature: "u"},
+		},
+	},
+	"zwp_tablet_pad_group_v2": {
+		Name: "zwp_tablet_pad_group_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "buttons", Signature: "a"},
+			{Name: "ring", Signature: "n", NewInterfaces: []string{"zwp_tablet_pad_ring_v2"}},
+			{Name: "strip", Signature: "n", NewInterfaces: []string{"zwp_tablet_pad_strip_v2"}},
+			{Name: "modes", Signature: "u"},
+			{Name: "done", Signature: ""},
+			{Name: "mode_switch", Signature: "uuu"},
+			{Name: "dial", Signature: "n", NewInterfaces: []string{"zwp_tablet_pad_dial_v2"}},
+		},
+	},
+	"zwp_tablet_pad_v2": {
+		Name: "zwp_tablet_pad_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "set_feedback", Signature: "usu"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "group", Signature: "n", NewInterfaces: []string{"zwp_tablet_pad_group_v2"}},
+			{Name: "path", Signature: "s"},
+			{Name: "buttons", Signature: "u"},
+			{Name: "done", Signature: ""},
+			{Name: "button", Signature: "uuu"},
+			{Name: "enter", Signature: "uoo"},
+			{Name: "leave", Signature: "uo"},
+			{Name: "removed", Signature: ""},
+		},
+	},
+	"zwp_tablet_pad_dial_v2": {
+		Name: "zwp_tablet_pad_dial_v2",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "set_feedback", Signature: "su"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "delta", Signature: "i"},
+			{Name: "frame", Signature: "u"},
+		},
+	},
+	"wp_viewporter": {
+		Name: "wp_viewporter",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_viewport", Signature: "no", NewInterfaces: []string{"wp_viewport"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "viewport_exists", Value: 0},
+				},
+			},
+		},
+	},
+	"wp_viewport": {
+		Name: "wp_viewport",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_source", Signature: "ffff"},
+			{Name: "set_destination", Signature: "ii"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "bad_value", Value: 0},
+					{Name: "bad_size", Value: 1},
+					{Name: "out_of_buffer", Value: 2},
+					{Name: "no_surface", Value: 3},
+				},
+			},
+		},
+	},
+	"xdg_wm_base": {
+		Name: "xdg_wm_base",
+		Version: 7,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_positioner", Signature: "n", NewInterfaces: []string{"xdg_positioner"}},
+			{Name: "get_xdg_surface", Signature: "no", NewInterfaces: []string{"xdg_surface"}},
+			{Name: "pong", Signature: "u"},
+		},
+		Events: []wayland.Method{
+			{Name: "ping", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "role", Value: 0},
+					{Name: "defunct_surfaces", Value: 1},
+					{Name: "not_the_topmost_popup", Value: 2},
+					{Name: "invalid_popup_parent", Value: 3},
+					{Name: "invalid_surface_state", Value: 4},
+					{Name: "invalid_positioner", Value: 5},
+					{Name: "unresponsive", Value: 6},
+				},
+			},
+		},
+	},
+	"xdg_positioner": {
+		Name: "xdg_positioner",
+		Version: 7,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_size", Signature: "ii"},
+			{Name: "set_anchor_rect", Signature: "iiii"},
+			{Name: "set_anchor", Signature: "u"},
+			{Name: "set_gravity", Signature: "u"},
+			{Name: "set_constraint_adjustment", Signature: "u"},
+			{Name: "set_offset", Signature: "ii"},
+			{Name: "set_reactive", Signature: ""},
+			{Name: "set_parent_size", Signature: "ii"},
+			{Name: "set_parent_configure", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_input", Value: 0},
+				},
+			},
+			{
+				Name: "anchor",
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "top", Value: 1},
+					{Name: "bottom", Value: 2},
+					{Name: "left", Value: 3},
+					{Name: "right", Value: 4},
+					{Name: "top_left", Value: 5},
+					{Name: "bottom_left", Value: 6},
+					{Name: "top_right", Value: 7},
+					{Name: "bottom_right", Value: 8},
+				},
+			},
+			{
+				Name: "gravity",
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "top", Value: 1},
+					{Name: "bottom", Value: 2},
+					{Name: "left", Value: 3},
+					{Name: "right", Value: 4},
+					{Name: "top_left", Value: 5},
+					{Name: "bottom_left", Value: 6},
+					{Name: "top_right", Value: 7},
+					{Name: "bottom_right", Value: 8},
+				},
+			},
+			{
+				Name: "constraint_adjustment",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "slide_x", Value: 1},
+					{Name: "slide_y", Value: 2},
+					{Name: "flip_x", Value: 4},
+					{Name: "flip_y", Value: 8},
+					{Name: "resize_x", Value: 16},
+					{Name: "resize_y", Value: 32},
+				},
+			},
+		},
+	},
+	"xdg_surface": {
+		Name: "xdg_surface",
+		Version: 7,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_toplevel", Signature: "n", NewInterfaces: []string{"xdg_toplevel"}},
+			{Name: "get_popup", Signature: "n?oo", NewInterfaces: []string{"xdg_popup"}},
+			{Name: "set_window_geometry", Signature: "iiii"},
+			{Name: "ack_configure", Signature: "u"},
+		},
+		Events: []wayland.Method{
+			{Name: "configure", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "not_constructed", Value: 1},
+					{Name: "already_constructed", Value: 2},
+					{Name: "unconfigured_buffer", Value: 3},
+					{Name: "invalid_serial", Value: 4},
+					{Name: "invalid_size", Value: 5},
+					{Name: "defunct_role_object", Value: 6},
+				},
+			},
+		},
+	},
+	"xdg_toplevel": {
+		Name: "xdg_toplevel",
+		Version: 7,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_parent", Signature: "?o"},
+			{Name: "set_title", Signature: "s"},
+			{Name: "set_app_id", Signature: "s"},
+			{Name: "show_window_menu", Signature: "ouii"},
+			{Name: "move", Signature: "ou"},
+			{Name: "resize", Signature: "ouu"},
+			{Name: "set_max_size", Signature: "ii"},
+			{Name: "set_min_size", Signature: "ii"},
+			{Name: "set_maximized", Signature: ""},
+			{Name: "unset_maximized", Signature: ""},
+			{Name: "set_fullscreen", Signature: "?o"},
+			{Name: "unset_fullscreen", Signature: ""},
+			{Name: "set_minimized", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "configure", Signature: "iia"},
+			{Name: "close", Signature: ""},
+			{Name: "configure_bounds", Signature: "ii"},
+			{Name: "wm_capabilities", Signature: "a"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_resize_edge", Value: 0},
+					{Name: "invalid_parent", Value: 1},
+					{Name: "invalid_size", Value: 2},
+				},
+			},
+			{
+				Name: "resize_edge",
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "top", Value: 1},
+					{Name: "bottom", Value: 2},
+					{Name: "left", Value: 4},
+					{Name: "top_left", Value: 5},
+					{Name: "bottom_left", Value: 6},
+					{Name: "right", Value: 8},
+					{Name: "top_right", Value: 9},
+					{Name: "bottom_right", Value: 10},
+				},
+			},
+			{
+				Name: "state",
+				Entries: []wayland.EnumEntry{
+					{Name: "maximized", Value: 1},
+					{Name: "fullscreen", Value: 2},
+					{Name: "resizing", Value: 3},
+					{Name: "activated", Value: 4},
+					{Name: "tiled_left", Value: 5},
+					{Name: "tiled_right", Value: 6},
+					{Name: "tiled_top", Value: 7},
+					{Name: "tiled_bottom", Value: 8},
+					{Name: "suspended", Value: 9},
+					{Name: "constrained_left", Value: 10},
+					{Name: "constrained_right", Value: 11},
+					{Name: "constrained_top", Value: 12},
+					{Name: "constrained_bottom", Value: 13},
+				},
+			},
+			{
+				Name: "wm_capabilities",
+				Entries: []wayland.EnumEntry{
+					{Name: "window_menu", Value: 1},
+					{Name: "maximize", Value: 2},
+					{Name: "fullscreen", Value: 3},
+					{Name: "minimize", Value: 4},
+				},
+			},
+		},
+	},
+	"xdg_popup": {
+		Name: "xdg_popup",
+		Version: 7,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "grab", Signature: "ou"},
+			{Name: "reposition", Signature: "ou"},
+		},
+		Events: []wayland.Method{
+			{Name: "configure", Signature: "iiii"},
+			{Name: "popup_done", Signature: ""},
+			{Name: "repositioned", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_grab", Value: 0},
+				},
+			},
+		},
+	},
+	"wp_alpha_modifier_v1": {
+		Name: "wp_alpha_modifier_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_surface", Signature: "no", NewInterfaces: []string{"wp_alpha_modifier_surface_v1"}},
+		},
+	},
+	"wp_alpha_modifier_surface_v1": {
+		Name: "wp_alpha_modifier_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_multiplier", Signature: "u"},
+		},
+	},
+	"wp_color_manager_v1": {
+		Name: "wp_color_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_output", Signature: "no", NewInterfaces: []string{"wp_color_management_output_v1"}},
+			{Name: "get_surface", Signature: "no", NewInterfaces: []string{"wp_color_management_surface_v1"}},
+			{Name: "get_surface_feedback", Signature: "no", NewInterfaces: []string{"wp_color_management_surface_feedback_v1"}},
+			{Name: "create_icc_creator", Signature: "n", NewInterfaces: []string{"wp_image_description_creator_icc_v1"}},
+			{Name: "create_parametric_creator", Signature: "n", NewInterfaces: []string{"wp_image_description_creator_params_v1"}},
+			{Name: "create_windows_scrgb", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "supported_intent", Signature: "u"},
+			{Name: "supported_feature", Signature: "u"},
+			{Name: "supported_tf_named", Signature: "u"},
+			{Name: "supported_primaries_named", Signature: "u"},
+			{Name: "done", Signature: ""},
+		},
+	},
+	"wp_color_management_output_v1": {
+		Name: "wp_color_management_output_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_image_description", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "image_description_changed", Signature: ""},
+		},
+	},
+	"wp_color_management_surface_v1": {
+		Name: "wp_color_management_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_image_description", Signature: "ou"},
+			{Name: "unset_image_description", Signature: ""},
+		},
+	},
+	"wp_color_management_surface_feedback_v1": {
+		Name: "wp_color_management_surface_feedback_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_preferred", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+			{Name: "get_preferred_parametric", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "preferred_changed", Signature: "u"},
+		},
+	},
+	"wp_image_description_creator_icc_v1": {
+		Name: "wp_image_description_creator_icc_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+			{Name: "set_icc_file", Signature: "huu"},
+		},
+	},
+	"wp_image_description_creator_params_v1": {
+		Name: "wp_image_description_creator_params_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create", Signature: "n", NewInterfaces: []string{"wp_image_description_v1"}},
+			{Name: "set_tf_named", Signature: "u"},
+			{Name: "set_tf_power", Signature: "u"},
+			{Name: "set_primaries_named", Signature: "u"},
+			{Name: "set_primaries", Signature: "iiiiiiii"},
+			{Name: "set_luminances", Signature: "uuu"},
+			{Name: "set_mastering_display_primaries", Signature: "iiiiiiii"},
+			{Name: "set_mastering_luminance", Signature: "uu"},
+			{Name: "set_max_cll", Signature: "u"},
+			{Name: "set_max_fall", Signature: "u"},
+		},
+	},
+	"wp_image_description_v1": {
+		Name: "wp_image_description_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_information", Signature: "n", NewInterfaces: []string{"wp_image_description_info_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "failed", Signature: "us"},
+			{Name: "ready", Signature: "u"},
+		},
+	},
+	"wp_image_description_info_v1": {
+		Name: "wp_image_description_info_v1",
+		Version: 1,
+		Events: []wayland.Method{
+			{Name: "done", Signature: ""},
+			{Name: "icc_file", Signature: "hu"},
+			{Name: "primaries", Signature: "iiiiiiii"},
+			{Name: "primaries_named", Signature: "u"},
+			{Name: "tf_power", Signature: "u"},
+			{Name: "tf_named", Signature: "u"},
+			{Name: "luminances", Signature: "uuu"},
+			{Name: "target_primaries", Signature: "iiiiiiii"},
+			{Name: "target_luminance", Signature: "uu"},
+			{Name: "target_max_cll", Signature: "u"},
+			{Name: "target_max_fall", Signature: "u"},
+		},
+	},
+	"wp_color_representation_manager_v1": {
+		Name: "wp_color_representation_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_surface", Signature: "no", NewInterfaces: []string{"wp_color_representation_surface_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "supported_alpha_mode", Signature: "u"},
+			{Name: "supported_coefficients_and_ranges", Signature: "uu"},
+			{Name: "done", Signature: ""},
+		},
+	},
+	"wp_color_representation_surface_v1": {
+		Name: "wp_color_representation_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_alpha_mode", Signature: "u"},
+			{Name: "set_coefficients_and_range", Signature: "uu"},
+			{Name: "set_chroma_location", Signature: "u"},
+		},
+	},
+	"wp_commit_timing_manager_v1": {
+		Name: "wp_commit_timing_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_timer", Signature: "no", NewInterfaces: []string{"wp_commit_timer_v1"}},
+		},
+	},
+	"wp_commit_timer_v1": {
+		Name: "wp_commit_timer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_timestamp", Signature: "uuu"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"wp_content_type_manager_v1": {
+		Name: "wp_content_type_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_surface_content_type", Signature: "no", NewInterfaces: []string{"wp_content_type_v1"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "already_constructed", Value: 0},
+				},
+			},
+		},
+	},
+	"wp_content_type_v1": {
+		Name: "wp_content_type_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_content_type", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "type",
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0},
+					{Name: "photo", Value: 1},
+					{Name: "video", Value: 2},
+					{Name: "game", Value: 3},
+				},
+			},
+		},
+	},
+	"wp_cursor_shape_manager_v1": {
+		Name: "wp_cursor_shape_manager_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_pointer", Signature: "no", NewInterfaces: []string{"wp_cursor_shape_device_v1"}},
+			{Name: "get_tablet_tool_v2", Signature: "no", NewInterfaces: []string{"wp_cursor_shape_device_v1"}},
+		},
+	},
+	"wp_cursor_shape_device_v1": {
+		Name: "wp_cursor_shape_device_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_shape", Signature: "uu"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "shape",
+				Entries: []wayland.EnumEntry{
+					{Name: "default", Value: 1},
+					{Name: "context_menu", Value: 2},
+					{Name: "help", Value: 3},
+					{Name: "pointer", Value: 4},
+					{Name: "progress", Value: 5},
+					{Name: "wait", Value: 6},
+					{Name: "cell", Value: 7},
+					{Name: "crosshair", Value: 8},
+					{Name: "text", Value: 9},
+					{Name: "vertical_text", Value: 10},
+					{Name: "alias", Value: 11},
+					{Name: "copy", Value: 12},
+					{Name: "move", Value: 13},
+					{Name: "no_drop", Value: 14},
+					{Name: "not_allowed", Value: 15},
+					{Name: "grab", Value: 16},
+					{Name: "grabbing", Value: 17},
+					{Name: "e_resize", Value: 18},
+					{Name: "n_resize", Value: 19},
+					{Name: "ne_resize", Value: 20},
+					{Name: "nw_resize", Value: 21},
+					{Name: "s_resize", Value: 22},
+					{Name: "se_resize", Value: 23},
+					{Name: "sw_resize", Value: 24},
+					{Name: "w_resize", Value: 25},
+					{Name: "ew_resize", Value: 26},
+					{Name: "ns_resize", Value: 27},
+					{Name: "nesw_resize", Value: 28},
+					{Name: "nwse_resize", Value: 29},
+					{Name: "col_resize", Value: 30},
+					{Name: "row_resize", Value: 31},
+					{Name: "all_scroll", Value: 32},
+					{Name: "zoom_in", Value: 33},
+					{Name: "zoom_out", Value: 34},
+					{Name: "dnd_ask", Value: 35},
+					{Name: "all_resize", Value: 36},
+				},
+			},
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "invalid_shape", Value: 1},
+				},
+			},
+		},
+	},
+	"wp_drm_lease_device_v1": {
+		Name: "wp_drm_lease_device_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_lease_request", Signature: "n", NewInterfaces: []string{"wp_drm_lease_request_v1"}},
+			{Name: "release", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "drm_fd", Signature: "h"},
+			{Name: "connector", Signature: "n", NewInterfaces: []string{"wp_drm_lease_connector_v1"}},
+			{Name: "done", Signature: ""},
+			{Name: "released", Signature: ""},
+		},
+	},
+	"wp_drm_lease_connector_v1": {
+		Name: "wp_drm_lease_connector_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "name", Signature: "s"},
+			{Name: "description", Signature: "s"},
+			{Name: "connector_id", Signature: "u"},
+			{Name: "done", Signature: ""},
+			{Name: "withdrawn", Signature: ""},
+		},
+	},
+	"wp_drm_lease_request_v1": {
+		Name: "wp_drm_lease_request_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "request_connector", Signature: "o"},
+			{Name: "submit", Signature: "n", NewInterfaces: []string{"wp_drm_lease_v1"}, Destructor: true},
+		},
+	},
+	"wp_drm_lease_v1": {
+		Name: "wp_drm_lease_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "lease_fd", Signature: "h"},
+			{Name: "finished", Signature: ""},
+		},
+	},
+	"ext_background_effect_manager_v1": {
+		Name: "ext_background_effect_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_background_effect", Signature: "no", NewInterfaces: []string{"ext_background_effect_surface_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "capabilities", Signature: "u"},
+		},
+	},
+	"ext_background_effect_surface_v1": {
+		Name: "ext_background_effect_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_blur_region", Signature: "o"},
+		},
+	},
+	"ext_data_control_manager_v1": {
+		Name: "ext_data_control_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_data_source", Signature: "n", NewInterfaces: []string{"ext_data_control_source_v1"}},
+			{Name: "get_data_device", Signature: "no", NewInterfaces: []string{"ext_data_control_device_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_data_control_device_v1": {
+		Name: "ext_data_control_device_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_selection", Signature: "?o"},
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_primary_selection", Signature: "?o"},
+		},
+		Events: []wayland.Method{
+			{Name: "data_offer", Signature: "n", NewInterfaces: []string{"ext_data_control_offer_v1"}},
+			{Name: "selection", Signature: "?o"},
+			{Name: "finished", Signature: ""},
+			{Name: "primary_selection", Signature: "?o"},
+		},
+	},
+	"ext_data_control_source_v1": {
+		Name: "ext_data_control_source_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "send", Signature: "sh"},
+			{Name: "cancelled", Signature: ""},
+		},
+	},
+	"ext_data_control_offer_v1": {
+		Name: "ext_data_control_offer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "receive", Signature: "sh"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+		},
+	},
+	"ext_foreign_toplevel_list_v1": {
+		Name: "ext_foreign_toplevel_list_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "stop", Signature: ""},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "toplevel", Signature: "n", NewInterfaces: []string{"ext_foreign_toplevel_handle_v1"}},
+			{Name: "finished", Signature: ""},
+		},
+	},
+	"ext_foreign_toplevel_handle_v1": {
+		Name: "ext_foreign_toplevel_handle_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "closed", Signature: ""},
+			{Name: "done", Signature: ""},
+			{Name: "title", Signature: "s"},
+			{Name: "app_id", Signature: "s"},
+			{Name: "identifier", Signature: "s"},
+		},
+	},
+	"ext_idle_notifier_v1": {
+		Name: "ext_idle_notifier_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_idle_notification", Signature: "nuo", NewInterfaces: []string{"ext_idle_notification_v1"}},
+			{Name: "get_input_idle_notification", Signature: "nuo", NewInterfaces: []string{"ext_idle_notification_v1"}},
+		},
+	},
+	"ext_idle_notification_v1": {
+		Name: "ext_idle_notification_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "idled", Signature: ""},
+			{Name: "resumed", Signature: ""},
+		},
+	},
+	"ext_image_capture_source_v1": {
+		Name: "ext_image_capture_source_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_output_image_capture_source_manager_v1": {
+		Name: "ext_output_image_capture_source_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_source", Signature: "no", NewInterfaces: []string{"ext_image_capture_source_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_foreign_toplevel_image_capture_source_manager_v1": {
+		Name: "ext_foreign_toplevel_image_capture_source_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_source", Signature: "no", NewInterfaces: []string{"ext_image_capture_source_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_image_copy_capture_manager_v1": {
+		Name: "ext_image_copy_capture_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_session", Signature: "nou", NewInterfaces: []string{"ext_image_copy_capture_session_v1"}},
+			{Name: "create_pointer_cursor_session", Signature: "noo", NewInterfaces: []string{"ext_image_copy_capture_cursor_session_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_image_copy_capture_session_v1": {
+		Name: "ext_image_copy_capture_session_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_frame", Signature: "n", NewInterfaces: []string{"ext_image_copy_capture_frame_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "buffer_size", Signature: "uu"},
+			{Name: "shm_format", Signature: "u"},
+			{Name: "dmabuf_device", Signature: "a"},
+			{Name: "dmabuf_format", Signature: "ua"},
+			{Name: "done", Signature: ""},
+			{Name: "stopped", Signature: ""},
+		},
+	},
+	"ext_image_copy_capture_frame_v1": {
+		Name: "ext_image_copy_capture_frame_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "attach_buffer", Signature: "o"},
+			{Name: "damage_buffer", Signature: "iiii"},
+			{Name: "capture", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "transform", Signature: "u"},
+			{Name: "damage", Signature: "iiii"},
+			{Name: "presentation_time", Signature: "uuu"},
+			{Name: "ready", Signature: ""},
+			{Name: "failed", Signature: "u"},
+		},
+	},
+	"ext_image_copy_capture_cursor_session_v1": {
+		Name: "ext_image_copy_capture_cursor_session_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_capture_session", Signature: "n", NewInterfaces: []string{"ext_image_copy_capture_session_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "enter", Signature: ""},
+			{Name: "leave", Signature: ""},
+			{Name: "position", Signature: "ii"},
+			{Name: "hotspot", Signature: "ii"},
+		},
+	},
+	"ext_session_lock_manager_v1": {
+		Name: "ext_session_lock_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "lock", Signature: "n", NewInterfaces: []string{"ext_session_lock_v1"}},
+		},
+	},
+	"ext_session_lock_v1": {
+		Name: "ext_session_lock_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_lock_surface", Signature: "noo", NewInterfaces: []string{"ext_session_lock_surface_v1"}},
+			{Name: "unlock_and_destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "locked", Signature: ""},
+			{Name: "finished", Signature: ""},
+		},
+	},
+	"ext_session_lock_surface_v1": {
+		Name: "ext_session_lock_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "ack_configure", Signature: "u"},
+		},
+		Events: []wayland.Method{
+			{Name: "configure", Signature: "uuu"},
+		},
+	},
+	"ext_transient_seat_manager_v1": {
+		Name: "ext_transient_seat_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create", Signature: "n", NewInterfaces: []string{"ext_transient_seat_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"ext_transient_seat_v1": {
+		Name: "ext_transient_seat_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "ready", Signature: "u"},
+			{Name: "denied", Signature: ""},
+		},
+	},
+	"ext_workspace_manager_v1": {
+		Name: "ext_workspace_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "commit", Signature: ""},
+			{Name: "stop", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "workspace_group", Signature: "n", NewInterfaces: []string{"ext_workspace_group_handle_v1"}},
+			{Name: "workspace", Signature: "n", NewInterfaces: []string{"ext_workspace_handle_v1"}},
+			{Name: "done", Signature: ""},
+			{Name: "finished", Signature: ""},
+		},
+	},
+	"ext_workspace_group_handle_v1": {
+		Name: "ext_workspace_group_handle_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_workspace", Signature: "s"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "capabilities", Signature: "u"},
+			{Name: "output_enter", Signature: "o"},
+			{Name: "output_leave", Signature: "o"},
+			{Name: "workspace_enter", Signature: "o"},
+			{Name: "workspace_leave", Signature: "o"},
+			{Name: "removed", Signature: ""},
+		},
+	},
+	"ext_workspace_handle_v1": {
+		Name: "ext_workspace_handle_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "activate", Signature: ""},
+			{Name: "deactivate", Signature: ""},
+			{Name: "assign", Signature: "o"},
+			{Name: "remove", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "id", Signature: "s"},
+			{Name: "name", Signature: "s"},
+			{Name: "coordinates", Signature: "a"},
+			{Name: "state", Signature: "u"},
+			{Name: "capabilities", Signature: "u"},
+			{Name: "removed", Signature: ""},
+		},
+	},
+	"wp_fifo_manager_v1": {
+		Name: "wp_fifo_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_fifo", Signature: "no", NewInterfaces: []string{"wp_fifo_v1"}},
+		},
+	},
+	"wp_fifo_v1": {
+		Name: "wp_fifo_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_barrier", Signature: ""},
+			{Name: "wait_barrier", Signature: ""},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"wp_fractional_scale_manager_v1": {
+		Name: "wp_fractional_scale_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_fractional_scale", Signature: "no", NewInterfaces: []string{"wp_fractional_scale_v1"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "fractional_scale_exists", Value: 0},
+				},
+			},
+		},
+	},
+	"wp_fractional_scale_v1": {
+		Name: "wp_fractional_scale_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "preferred_scale", Signature: "u"},
+		},
+	},
+	"wp_linux_drm_syncobj_manager_v1": {
+		Name: "wp_linux_drm_syncobj_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_surface", Signature: "no", NewInterfaces: []string{"wp_linux_drm_syncobj_surface_v1"}},
+			{Name: "import_timeline", Signature: "nh", NewInterfaces: []string{"wp_linux_drm_syncobj_timeline_v1"}},
+		},
+	},
+	"wp_linux_drm_syncobj_timeline_v1": {
+		Name: "wp_linux_drm_syncobj_timeline_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"wp_linux_drm_syncobj_surface_v1": {
+		Name: "wp_linux_drm_syncobj_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_acquire_point", Signature: "ouu"},
+			{Name: "set_release_point", Signature: "ouu"},
+		},
+	},
+	"wp_pointer_warp_v1": {
+		Name: "wp_pointer_warp_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "warp_pointer", Signature: "ooffu"},
+		},
+	},
+	"wp_security_context_manager_v1": {
+		Name: "wp_security_context_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_listener", Signature: "nhh", NewInterfaces: []string{"wp_security_context_v1"}},
+		},
+	},
+	"wp_security_context_v1": {
+		Name: "wp_security_context_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_sandbox_engine", Signature: "s"},
+			{Name: "set_app_id", Signature: "s"},
+			{Name: "set_instance_id", Signature: "s"},
+			{Name: "commit", Signature: ""},
+		},
+	},
+	"wp_single_pixel_buffer_manager_v1": {
+		Name: "wp_single_pixel_buffer_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_u32_rgba_buffer", Signature: "nuuuu", NewInterfaces: []string{"wl_buffer"}},
+		},
+	},
+	"wp_tearing_control_manager_v1": {
+		Name: "wp_tearing_control_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_tearing_control", Signature: "no", NewInterfaces: []string{"wp_tearing_control_v1"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "tearing_control_exists", Value: 0},
+				},
+			},
+		},
+	},
+	"wp_tearing_control_v1": {
+		Name: "wp_tearing_control_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_presentation_hint", Signature: "u"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "presentation_hint",
+				Entries: []wayland.EnumEntry{
+					{Name: "vsync", Value: 0},
+					{Name: "async", Value: 1},
+				},
+			},
+		},
+	},
+	"xdg_activation_v1": {
+		Name: "xdg_activation_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_activation_token", Signature: "n", NewInterfaces: []string{"xdg_activation_token_v1"}},
+			{Name: "activate", Signature: "so"},
+		},
+	},
+	"xdg_activation_token_v1": {
+		Name: "xdg_activation_token_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_serial", Signature: "uo"},
+			{Name: "set_app_id", Signature: "s"},
+			{Name: "set_surface", Signature: "o"},
+			{Name: "commit", Signature: ""},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "done", Signature: "s"},
+		},
+	},
+	"xdg_wm_dialog_v1": {
+		Name: "xdg_wm_dialog_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_xdg_dialog", Signature: "no", NewInterfaces: []string{"xdg_dialog_v1"}},
+		},
+	},
+	"xdg_dialog_v1": {
+		Name: "xdg_dialog_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_modal", Signature: ""},
+			{Name: "unset_modal", Signature: ""},
+		},
+	},
+	"xdg_system_bell_v1": {
+		Name: "xdg_system_bell_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "ring", Signature: "?o"},
+		},
+	},
+	"xdg_toplevel_drag_manager_v1": {
+		Name: "xdg_toplevel_drag_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_xdg_toplevel_drag", Signature: "no", NewInterfaces: []string{"xdg_toplevel_drag_v1"}},
+		},
+	},
+	"xdg_toplevel_drag_v1": {
+		Name: "xdg_toplevel_drag_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "attach", Signature: "oii"},
+		},
+	},
+	"xdg_toplevel_icon_manager_v1": {
+		Name: "xdg_toplevel_icon_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_icon", Signature: "n", NewInterfaces: []string{"xdg_toplevel_icon_v1"}},
+			{Name: "set_icon", Signature: "o?o"},
+		},
+		Events: []wayland.Method{
+			{Name: "icon_size", Signature: "i"},
+			{Name: "done", Signature: ""},
+		},
+	},
+	"xdg_toplevel_icon_v1": {
+		Name: "xdg_toplevel_icon_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_name", Signature: "s"},
+			{Name: "add_buffer", Signature: "oi"},
+		},
+	},
+	"xdg_toplevel_tag_manager_v1": {
+		Name: "xdg_toplevel_tag_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_toplevel_tag", Signature: "os"},
+			{Name: "set_toplevel_description", Signature: "os"},
+		},
+	},
+	"xwayland_shell_v1": {
+		Name: "xwayland_shell_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_xwayland_surface", Signature: "no", NewInterfaces: []string{"xwayland_surface_v1"}},
+		},
+	},
+	"xwayland_surface_v1": {
+		Name: "xwayland_surface_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_serial", Signature: "uu"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"xx_input_method_v1": {
+		Name: "xx_input_method_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "commit_string", Signature: "s"},
+			{Name: "set_preedit_string", Signature: "sii"},
+			{Name: "delete_surrounding_text", Signature: "uu"},
+			{Name: "commit", Signature: "u"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "activate", Signature: ""},
+			{Name: "deactivate", Signature: ""},
+			{Name: "surrounding_text", Signature: "suu"},
+			{Name: "text_change_cause", Signature: "u"},
+			{Name: "content_type", Signature: "uu"},
+			{Name: "done", Signature: ""},
+			{Name: "unavailable", Signature: ""},
+		},
+	},
+	"xx_input_method_manager_v2": {
+		Name: "xx_input_method_manager_v2",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "get_input_method", Signature: "on", NewInterfaces: []string{"xx_input_method_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"xx_session_manager_v1": {
+		Name: "xx_session_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_session", Signature: "nus", NewInterfaces: []string{"xx_session_v1"}},
+		},
+	},
+	"xx_session_v1": {
+		Name: "xx_session_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "remove", Signature: "", Destructor: true},
+			{Name: "add_toplevel", Signature: "nos", NewInterfaces: []string{"xx_toplevel_session_v1"}},
+			{Name: "restore_toplevel", Signature: "nos", NewInterfaces: []string{"xx_toplevel_session_v1"}},
+		},
+		Events: []wayland.Method{
+			{Name: "created", Signature: "s"},
+			{Name: "restored", Signature: ""},
+			{Name: "replaced", Signature: ""},
+		},
+	},
+	"xx_toplevel_session_v1": {
+		Name: "xx_toplevel_session_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "remove", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "restored", Signature: "o"},
+		},
+	},
+	"zwp_idle_inhibit_manager_v1": {
+		Name: "zwp_idle_inhibit_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "create_inhibitor", Signature: "no", NewInterfaces: []string{"zwp_idle_inhibitor_v1"}},
+		},
+	},
+	"zwp_idle_inhibitor_v1": {
+		Name: "zwp_idle_inhibitor_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"zwp_keyboard_shortcuts_inhibit_manager_v1": {
+		Name: "zwp_keyboard_shortcuts_inhibit_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "inhibit_shortcuts", Signature: "noo", NewInterfaces: []string{"zwp_keyboard_shortcuts_inhibitor_v1"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "already_inhibited", Value: 0},
+				},
+			},
+		},
+	},
+	"zwp_keyboard_shortcuts_inhibitor_v1": {
+		Name: "zwp_keyboard_shortcuts_inhibitor_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "active", Signature: ""},
+			{Name: "inactive", Signature: ""},
+		},
+	},
+	"zwp_pointer_constraints_v1": {
+		Name: "zwp_pointer_constraints_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "lock_pointer", Signature: "noo?ou", NewInterfaces: []string{"zwp_locked_pointer_v1"}},
+			{Name: "confine_pointer", Signature: "noo?ou", NewInterfaces: []string{"zwp_confined_pointer_v1"}},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "already_constrained", Value: 1},
+				},
+			},
+			{
+				Name: "lifetime",
+				Entries: []wayland.EnumEntry{
+					{Name: "oneshot", Value: 1},
+					{Name: "persistent", Value: 2},
+				},
+			},
+		},
+	},
+	"zwp_locked_pointer_v1": {
+		Name: "zwp_locked_pointer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_cursor_position_hint", Signature: "ff"},
+			{Name: "set_region", Signature: "?o"},
+		},
+		Events: []wayland.Method{
+			{Name: "locked", Signature: ""},
+			{Name: "unlocked", Signature: ""},
+		},
+	},
+	"zwp_confined_pointer_v1": {
+		Name: "zwp_confined_pointer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_region", Signature: "?o"},
+		},
+		Events: []wayland.Method{
+			{Name: "confined", Signature: ""},
+			{Name: "unconfined", Signature: ""},
+		},
+	},
+	"zwp_pointer_gestures_v1": {
+		Name: "zwp_pointer_gestures_v1",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "get_swipe_gesture", Signature: "no", NewInterfaces: []string{"zwp_pointer_gesture_swipe_v1"}},
+			{Name: "get_pinch_gesture", Signature: "no", NewInterfaces: []string{"zwp_pointer_gesture_pinch_v1"}},
+			{Name: "release", Signature: "", Destructor: true},
+			{Name: "get_hold_gesture", Signature: "no", NewInterfaces: []string{"zwp_pointer_gesture_hold_v1"}},
+		},
+	},
+	"zwp_pointer_gesture_swipe_v1": {
+		Name: "zwp_pointer_gesture_swipe_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "begin", Signature: "uuou"},
+			{Name: "update", Signature: "uff"},
+			{Name: "end", Signature: "uui"},
+		},
+	},
+	"zwp_pointer_gesture_pinch_v1": {
+		Name: "zwp_pointer_gesture_pinch_v1",
+		Version: 2,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "begin", Signature: "uuou"},
+			{Name: "update", Signature: "uffff"},
+			{Name: "end", Signature: "uui"},
+		},
+	},
+	"zwp_pointer_gesture_hold_v1": {
+		Name: "zwp_pointer_gesture_hold_v1",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "begin", Signature: "uuou"},
+			{Name: "end", Signature: "uui"},
+		},
+	},
+	"zwp_primary_selection_device_manager_v1": {
+		Name: "zwp_primary_selection_device_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "create_source", Signature: "n", NewInterfaces: []string{"zwp_primary_selection_source_v1"}},
+			{Name: "get_device", Signature: "no", NewInterfaces: []string{"zwp_primary_selection_device_v1"}},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+	},
+	"zwp_primary_selection_device_v1": {
+		Name: "zwp_primary_selection_device_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "set_selection", Signature: "?ou"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "data_offer", Signature: "n", NewInterfaces: []string{"zwp_primary_selection_offer_v1"}},
+			{Name: "selection", Signature: "?o"},
+		},
+	},
+	"zwp_primary_selection_offer_v1": {
+		Name: "zwp_primary_selection_offer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "receive", Signature: "sh"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+		},
+	},
+	"zwp_primary_selection_source_v1": {
+		Name: "zwp_primary_selection_source_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "offer", Signature: "s"},
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "send", Signature: "sh"},
+			{Name: "cancelled", Signature: ""},
+		},
+	},
+	"zwp_relative_pointer_manager_v1": {
+		Name: "zwp_relative_pointer_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_relative_pointer", Signature: "no", NewInterfaces: []string{"zwp_relative_pointer_v1"}},
+		},
+	},
+	"zwp_relative_pointer_v1": {
+		Name: "zwp_relative_pointer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "relative_motion", Signature: "uuffff"},
+		},
+	},
+	"zwp_text_input_v3": {
+		Name: "zwp_text_input_v3",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "enable", Signature: ""},
+			{Name: "disable", Signature: ""},
+			{Name: "set_surrounding_text", Signature: "sii"},
+			{Name: "set_text_change_cause", Signature: "u"},
+			{Name: "set_content_type", Signature: "uu"},
+			{Name: "set_cursor_rectangle", Signature: "iiii"},
+			{Name: "commit", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "enter", Signature: "o"},
+			{Name: "leave", Signature: "o"},
+			{Name: "preedit_string", Signature: "?sii"},
+			{Name: "commit_string", Signature: "?s"},
+			{Name: "delete_surrounding_text", Signature: "uu"},
+			{Name: "done", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "change_cause",
+				Entries: []wayland.EnumEntry{
+					{Name: "input_method", Value: 0},
+					{Name: "other", Value: 1},
+				},
+			},
+			{
+				Name: "content_hint",
+				Bitfield: true,
+				Entries: []wayland.EnumEntry{
+					{Name: "none", Value: 0x0},
+					{Name: "completion", Value: 0x1},
+					{Name: "spellcheck", Value: 0x2},
+					{Name: "auto_capitalization", Value: 0x4},
+					{Name: "lowercase", Value: 0x8},
+					{Name: "uppercase", Value: 0x10},
+					{Name: "titlecase", Value: 0x20},
+					{Name: "hidden_text", Value: 0x40},
+					{Name: "sensitive_data", Value: 0x80},
+					{Name: "latin", Value: 0x100},
+					{Name: "multiline", Value: 0x200},
+				},
+			},
+			{
+				Name: "content_purpose",
+				Entries: []wayland.EnumEntry{
+					{Name: "normal", Value: 0},
+					{Name: "alpha", Value: 1},
+					{Name: "digits", Value: 2},
+					{Name: "number", Value: 3},
+					{Name: "phone", Value: 4},
+					{Name: "url", Value: 5},
+					{Name: "email", Value: 6},
+					{Name: "name", Value: 7},
+					{Name: "password", Value: 8},
+					{Name: "pin", Value: 9},
+					{Name: "date", Value: 10},
+					{Name: "time", Value: 11},
+					{Name: "datetime", Value: 12},
+					{Name: "terminal", Value: 13},
+				},
+			},
+		},
+	},
+	"zwp_text_input_manager_v3": {
+		Name: "zwp_text_input_manager_v3",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_text_input", Signature: "no", NewInterfaces: []string{"zwp_text_input_v3"}},
+		},
+	},
+	"zxdg_decoration_manager_v1": {
+		Name: "zxdg_decoration_manager_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_toplevel_decoration", Signature: "no", NewInterfaces: []string{"zxdg_toplevel_decoration_v1"}},
+		},
+	},
+	"zxdg_toplevel_decoration_v1": {
+		Name: "zxdg_toplevel_decoration_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_mode", Signature: "u"},
+			{Name: "unset_mode", Signature: ""},
+		},
+		Events: []wayland.Method{
+			{Name: "configure", Signature: "u"},
+		},
+		Enums: []wayland.Enum{
+			{
+				Name: "error",
+				Entries: []wayland.EnumEntry{
+					{Name: "unconfigured_buffer", Value: 0},
+					{Name: "already_constructed", Value: 1},
+					{Name: "orphaned", Value: 2},
+					{Name: "invalid_mode", Value: 3},
+				},
+			},
+			{
+				Name: "mode",
+				Entries: []wayland.EnumEntry{
+					{Name: "client_side", Value: 1},
+					{Name: "server_side", Value: 2},
+				},
+			},
+		},
+	},
+	"zxdg_exporter_v1": {
+		Name: "zxdg_exporter_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "export", Signature: "no", NewInterfaces: []string{"zxdg_exported_v1"}},
+		},
+	},
+	"zxdg_importer_v1": {
+		Name: "zxdg_importer_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "import", Signature: "ns", NewInterfaces: []string{"zxdg_imported_v1"}},
+		},
+	},
+	"zxdg_exported_v1": {
+		Name: "zxdg_exported_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "handle", Signature: "s"},
+		},
+	},
+	"zxdg_imported_v1": {
+		Name: "zxdg_imported_v1",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_parent_of", Signature: "o"},
+		},
+		Events: []wayland.Method{
+			{Name: "destroyed", Signature: ""},
+		},
+	},
+	"zxdg_exporter_v2": {
+		Name: "zxdg_exporter_v2",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "export_toplevel", Signature: "no", NewInterfaces: []string{"zxdg_exported_v2"}},
+		},
+	},
+	"zxdg_importer_v2": {
+		Name: "zxdg_importer_v2",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "import_toplevel", Signature: "ns", NewInterfaces: []string{"zxdg_imported_v2"}},
+		},
+	},
+	"zxdg_exported_v2": {
+		Name: "zxdg_exported_v2",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "handle", Signature: "s"},
+		},
+	},
+	"zxdg_imported_v2": {
+		Name: "zxdg_imported_v2",
+		Version: 1,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "set_parent_of", Signature: "o"},
+		},
+		Events: []wayland.Method{
+			{Name: "destroyed", Signature: ""},
+		},
+	},
+	"zxdg_output_manager_v1": {
+		Name: "zxdg_output_manager_v1",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+			{Name: "get_xdg_output", Signature: "no", NewInterfaces: []string{"zxdg_output_v1"}},
+		},
+	},
+	"zxdg_output_v1": {
+		Name: "zxdg_output_v1",
+		Version: 3,
+		Requests: []wayland.Method{
+			{Name: "destroy", Signature: "", Destructor: true},
+		},
+		Events: []wayland.Method{
+			{Name: "logical_position", Signature: "ii"},
+			{Name: "logical_size", Signature: "ii"},
+			{Name: "done", Signature: ""},
+			{Name: "name", Signature: "s"},
+			{Name: "description", Signature: "s"},
+		},
+	},
+}
@@ -0,0 +1,364 @@
+// Package kde contains the bindings of the protocols of the kde protocol root, which build on the bindings of package
+// wlclient.
+package kde
+
+import (
+	"strconv"
+
+	"git.whizanth.com/go/wayland"
+	"git.whizanth.com/go/wayland/wlclient"
+)
+
+// OrgKdeKwinBlurManager is the org_kde_kwin_blur_manager interface
+type OrgKdeKwinBlurManager wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinBlurManager) Interface() string {
+	return "org_kde_kwin_blur_manager"
+}
+
+// Create sends the create request
+func (object OrgKdeKwinBlurManager) Create(surface wlclient.WlSurface) (OrgKdeKwinBlur, error) {
+	if err := wlclient.Object(object).CheckRequest("create", 1); err != nil {
+		return OrgKdeKwinBlur{}, err
+	}
+
+	id := OrgKdeKwinBlur(wlclient.Object(object).Client().NewObject("org_kde_kwin_blur", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), wlclient.Object(surface).Id())); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return OrgKdeKwinBlur{}, err
+	}
+
+	return id, nil
+}
+
+// Unset sends the unset request
+func (object OrgKdeKwinBlurManager) Unset(surface wlclient.WlSurface) error {
+	if err := wlclient.Object(object).CheckRequest("unset", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, wlclient.Object(surface).Id()))
+}
+
+// OrgKdeKwinBlur is the org_kde_kwin_blur interface
+type OrgKdeKwinBlur wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinBlur) Interface() string {
+	return "org_kde_kwin_blur"
+}
+
+// Commit sends the commit request
+func (object OrgKdeKwinBlur) Commit() error {
+	if err := wlclient.Object(object).CheckRequest("commit", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// SetRegion sends the set_region request
+//
+// Arguments:
+//   - region: nil for null
+func (object OrgKdeKwinBlur) SetRegion(region *wlclient.WlRegion) error {
+	if err := wlclient.Object(object).CheckRequest("set_region", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, wlclient.NullableId(region)))
+}
+
+// Release sends the release request: release the blur object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object OrgKdeKwinBlur) Release() error {
+	if err := wlclient.Object(object).CheckRequest("release", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 2))
+}
+
+// OrgKdeKwinIdle is the org_kde_kwin_idle interface: User idle time manager
+type OrgKdeKwinIdle wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinIdle) Interface() string {
+	return "org_kde_kwin_idle"
+}
+
+// GetIdleTimeout sends the get_idle_timeout request
+//
+// Arguments:
+//   - timeout: The idle timeout in msec
+func (object OrgKdeKwinIdle) GetIdleTimeout(seat wlclient.WlSeat, timeout uint32) (OrgKdeKwinIdleTimeout, error) {
+	if err := wlclient.Object(object).CheckRequest("get_idle_timeout", 1); err != nil {
+		return OrgKdeKwinIdleTimeout{}, err
+	}
+
+	id := OrgKdeKwinIdleTimeout(wlclient.Object(object).Client().NewObject("org_kde_kwin_idle_timeout", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), wlclient.Object(seat).Id(), timeout)); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return OrgKdeKwinIdleTimeout{}, err
+	}
+
+	return id, nil
+}
+
+// OrgKdeKwinIdleTimeout is the org_kde_kwin_idle_timeout interface
+type OrgKdeKwinIdleTimeout wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinIdleTimeout) Interface() string {
+	return "org_kde_kwin_idle_timeout"
+}
+
+// Release sends the release request: release the timeout object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object OrgKdeKwinIdleTimeout) Release() error {
+	if err := wlclient.Object(object).CheckRequest("release", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// SimulateUserActivity sends the simulate_user_activity request: Simulates user activity for this timeout, behaves just
+// like real user activity on the seat
+func (object OrgKdeKwinIdleTimeout) SimulateUserActivity() error {
+	if err := wlclient.Object(object).CheckRequest("simulate_user_activity", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+// OnIdle registers a listener for the idle event: Triggered when there has not been any user activity in the requested
+// idle time interval
+func (object OrgKdeKwinIdleTimeout) OnIdle(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnResumed registers a listener for the resumed event: Triggered on the first user activity after an idle event
+func (object OrgKdeKwinIdleTimeout) OnResumed(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OrgKdeKwinServerDecorationManager is the org_kde_kwin_server_decoration_manager interface: Server side window
+// decoration manager
+type OrgKdeKwinServerDecorationManager wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinServerDecorationManager) Interface() string {
+	return "org_kde_kwin_server_decoration_manager"
+}
+
+// OrgKdeKwinServerDecorationManagerMode is the mode enum of org_kde_kwin_server_decoration_manager: Possible values to
+// use in request_mode and the event mode.
+type OrgKdeKwinServerDecorationManagerMode uint32
+
+const (
+	// Undecorated: The surface is not decorated at all, neither server nor client-side.
+	OrgKdeKwinServerDecorationManagerModeNone OrgKdeKwinServerDecorationManagerMode = 0
+	// Client-side decoration: The decoration is part of the surface and the client.
+	OrgKdeKwinServerDecorationManagerModeClient OrgKdeKwinServerDecorationManagerMode = 1
+	// Server-side decoration: The server embeds the surface into a decoration frame.
+	OrgKdeKwinServerDecorationManagerModeServer OrgKdeKwinServerDecorationManagerMode = 2
+)
+
+func (value OrgKdeKwinServerDecorationManagerMode) String() string {
+	switch value {
+	case OrgKdeKwinServerDecorationManagerModeNone:
+		return "None"
+	case OrgKdeKwinServerDecorationManagerModeClient:
+		return "Client"
+	case OrgKdeKwinServerDecorationManagerModeServer:
+		return "Server"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Create sends the create request: Create a server-side decoration object for a given surface
+func (object OrgKdeKwinServerDecorationManager) Create(surface wlclient.WlSurface) (OrgKdeKwinServerDecoration, error) {
+	if err := wlclient.Object(object).CheckRequest("create", 1); err != nil {
+		return OrgKdeKwinServerDecoration{}, err
+	}
+
+	id := OrgKdeKwinServerDecoration(wlclient.Object(object).Client().NewObject("org_kde_kwin_server_decoration", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), wlclient.Object(surface).Id())); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return OrgKdeKwinServerDecoration{}, err
+	}
+
+	return id, nil
+}
+
+// OnDefaultMode registers a listener for the default_mode event: The default mode used on the server
+//
+// Arguments:
+//   - mode: The default decoration mode applied to newly created server decorations.
+func (object OrgKdeKwinServerDecorationManager) OnDefaultMode(listener func(mode uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadUint32())
+	})
+}
+
+// OrgKdeKwinServerDecoration is the org_kde_kwin_server_decoration interface
+type OrgKdeKwinServerDecoration wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (OrgKdeKwinServerDecoration) Interface() string {
+	return "org_kde_kwin_server_decoration"
+}
+
+// OrgKdeKwinServerDecorationMode is the mode enum of org_kde_kwin_server_decoration: Possible values to use in
+// request_mode and the event mode.
+type OrgKdeKwinServerDecorationMode uint32
+
+const (
+	// Undecorated: The surface is not decorated at all, neither server nor client-side.
+	OrgKdeKwinServerDecorationModeNone OrgKdeKwinServerDecorationMode = 0
+	// Client-side decoration: The decoration is part of the surface and the client.
+	OrgKdeKwinServerDecorationModeClient OrgKdeKwinServerDecorationMode = 1
+	// Server-side decoration: The server embeds the surface into a decoration frame.
+	OrgKdeKwinServerDecorationModeServer OrgKdeKwinServerDecorationMode = 2
+)
+
+func (value OrgKdeKwinServerDecorationMode) String() string {
+	switch value {
+	case OrgKdeKwinServerDecorationModeNone:
+		return "None"
+	case OrgKdeKwinServerDecorationModeClient:
+		return "Client"
+	case OrgKdeKwinServerDecorationModeServer:
+		return "Server"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Release sends the release request: release the server decoration object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object OrgKdeKwinServerDecoration) Release() error {
+	if err := wlclient.Object(object).CheckRequest("release", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// RequestMode sends the request_mode request: The decoration mode the surface wants to use.
+//
+// Arguments:
+//   - mode: The mode this surface wants to use.
+func (object OrgKdeKwinServerDecoration) RequestMode(mode uint32) error {
+	if err := wlclient.Object(object).CheckRequest("request_mode", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, mode))
+}
+
+// OnMode registers a listener for the mode event: The new decoration mode applied by the server
+//
+// Arguments:
+//   - mode: The decoration mode applied to the surface by the server.
+func (object OrgKdeKwinServerDecoration) OnMode(listener func(mode uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadUint32())
+	})
+}
+
+func init() {
+	for _, iface := range []*wayland.Interface{
+		{
+			Name: "org_kde_kwin_blur_manager",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "create", Signature: "no", NewInterfaces: []string{"org_kde_kwin_blur"}},
+				{Name: "unset", Signature: "o"},
+			},
+		},
+		{
+			Name: "org_kde_kwin_blur",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "commit", Signature: ""},
+				{Name: "set_region", Signature: "?o"},
+				{Name: "release", Signature: "", Destructor: true},
+			},
+		},
+		{
+			Name: "org_kde_kwin_idle",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "get_idle_timeout", Signature: "nou", NewInterfaces: []string{"org_kde_kwin_idle_timeout"}},
+			},
+		},
+		{
+			Name: "org_kde_kwin_idle_timeout",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "release", Signature: "", Destructor: true},
+				{Name: "simulate_user_activity", Signature: ""},
+			},
+			Events: []wayland.Method{
+				{Name: "idle", Signature: ""},
+				{Name: "resumed", Signature: ""},
+			},
+		},
+		{
+			Name: "org_kde_kwin_server_decoration_manager",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "create", Signature: "no", NewInterfaces: []string{"org_kde_kwin_server_decoration"}},
+			},
+			Events: []wayland.Method{
+				{Name: "default_mode", Signature: "u"},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "mode",
+					Entries: []wayland.EnumEntry{
+						{Name: "None", Value: 0},
+						{Name: "Client", Value: 1},
+						{Name: "Server", Value: 2},
+					},
+				},
+			},
+		},
+		{
+			Name: "org_kde_kwin_server_decoration",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "release", Signature: "", Destructor: true},
+				{Name: "request_mode", Signature: "u"},
+			},
+			Events: []wayland.Method{
+				{Name: "mode", Signature: "u"},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "mode",
+					Entries: []wayland.EnumEntry{
+						{Name: "None", Value: 0},
+						{Name: "Client", Value: 1},
+						{Name: "Server", Value: 2},
+					},
+				},
+			},
+		},
+	} {
+		wlclient.RegisterInterface(iface)
+	}
+}
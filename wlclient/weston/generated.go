@@ -0,0 +1,285 @@
+// Package weston contains the bindings of the protocols of the weston protocol root, which build on the bindings of
+// package wlclient.
+package weston
+
+import (
+	"strconv"
+
+	"git.whizanth.com/go/wayland"
+	"git.whizanth.com/go/wayland/wlclient"
+)
+
+// IviSurface is the ivi_surface interface: application interface to surface in ivi compositor
+type IviSurface wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (IviSurface) Interface() string {
+	return "ivi_surface"
+}
+
+// Destroy sends the destroy request: destroy ivi_surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object IviSurface) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// OnConfigure registers a listener for the configure event: suggest resize
+func (object IviSurface) OnConfigure(listener func(width int32, height int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// IviApplication is the ivi_application interface: create ivi-style surfaces
+type IviApplication wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (IviApplication) Interface() string {
+	return "ivi_application"
+}
+
+// IviApplicationError is the error enum of ivi_application
+type IviApplicationError uint32
+
+const (
+	// given wl_surface has another role
+	IviApplicationErrorRole IviApplicationError = 0
+	// given ivi_id is assigned to another wl_surface
+	IviApplicationErrorIviId IviApplicationError = 1
+)
+
+func (value IviApplicationError) String() string {
+	switch value {
+	case IviApplicationErrorRole:
+		return "role"
+	case IviApplicationErrorIviId:
+		return "ivi_id"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// SurfaceCreate sends the surface_create request: create ivi_surface with numeric ID in ivi compositor
+func (object IviApplication) SurfaceCreate(iviId uint32, surface wlclient.WlSurface) (IviSurface, error) {
+	if err := wlclient.Object(object).CheckRequest("surface_create", 1); err != nil {
+		return IviSurface{}, err
+	}
+
+	id := IviSurface(wlclient.Object(object).Client().NewObject("ivi_surface", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, iviId, wlclient.Object(surface).Id(), wlclient.Object(id).Id())); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return IviSurface{}, err
+	}
+
+	return id, nil
+}
+
+// TextCursorPosition is the text_cursor_position interface
+type TextCursorPosition wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (TextCursorPosition) Interface() string {
+	return "text_cursor_position"
+}
+
+// Notify sends the notify request
+func (object TextCursorPosition) Notify(surface wlclient.WlSurface, x wayland.Fixed, y wayland.Fixed) error {
+	if err := wlclient.Object(object).CheckRequest("notify", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(surface).Id(), x, y))
+}
+
+// WestonDebugV1 is the weston_debug_v1 interface: weston internal debugging
+type WestonDebugV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WestonDebugV1) Interface() string {
+	return "weston_debug_v1"
+}
+
+// Destroy sends the destroy request: destroy factory object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WestonDebugV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// Subscribe sends the subscribe request: subscribe to a debug stream
+//
+// Arguments:
+//   - name: debug stream name
+//   - streamfd: write stream file descriptor
+//   - stream: created debug stream object
+func (object WestonDebugV1) Subscribe(name string, streamfd int) (WestonDebugStreamV1, error) {
+	if err := wlclient.Object(object).CheckRequest("subscribe", 1); err != nil {
+		return WestonDebugStreamV1{}, err
+	}
+
+	stream := WestonDebugStreamV1(wlclient.Object(object).Client().NewObject("weston_debug_stream_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, name, wlclient.Object(stream).Id()).WithFds(streamfd)); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(stream).Id())
+		return WestonDebugStreamV1{}, err
+	}
+
+	return stream, nil
+}
+
+// OnAvailable registers a listener for the available event: advertise available debug scope
+//
+// Arguments:
+//   - name: debug stream name
+//   - description: human-readable description of the debug scope, nil for null
+func (object WestonDebugV1) OnAvailable(listener func(name string, description *string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadString(), message.ReadNullableString())
+	})
+}
+
+// WestonDebugStreamV1 is the weston_debug_stream_v1 interface: A subscribed debug stream
+type WestonDebugStreamV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WestonDebugStreamV1) Interface() string {
+	return "weston_debug_stream_v1"
+}
+
+// Destroy sends the destroy request: close a debug stream
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WestonDebugStreamV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// OnComplete registers a listener for the complete event: server completed the debug stream
+func (object WestonDebugStreamV1) OnComplete(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnFailure registers a listener for the failure event: server cannot continue the debug stream
+//
+// Arguments:
+//   - message: human readable reason, nil for null
+func (object WestonDebugStreamV1) OnFailure(listener func(message *string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(message.ReadNullableString())
+	})
+}
+
+// WestonDirectDisplayV1 is the weston_direct_display_v1 interface: weston direct display
+type WestonDirectDisplayV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (WestonDirectDisplayV1) Interface() string {
+	return "weston_direct_display_v1"
+}
+
+// Enable sends the enable request: forces buffer to be sent directly to display
+//
+// Arguments:
+//   - dmabuf: enable direct-display for dmabuf buffer
+func (object WestonDirectDisplayV1) Enable(dmabuf wlclient.ZwpLinuxBufferParamsV1) error {
+	if err := wlclient.Object(object).CheckRequest("enable", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(dmabuf).Id()))
+}
+
+// Destroy sends the destroy request: destroy factory object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object WestonDirectDisplayV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+func init() {
+	for _, iface := range []*wayland.Interface{
+		{
+			Name: "ivi_surface",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+			Events: []wayland.Method{
+				{Name: "configure", Signature: "ii"},
+			},
+		},
+		{
+			Name: "ivi_application",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "surface_create", Signature: "uon", NewInterfaces: []string{"ivi_surface"}},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "role", Value: 0},
+						{Name: "ivi_id", Value: 1},
+					},
+				},
+			},
+		},
+		{
+			Name: "text_cursor_position",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "notify", Signature: "off"},
+			},
+		},
+		{
+			Name: "weston_debug_v1",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "destroy", Signature: "", Destructor: true},
+				{Name: "subscribe", Signature: "shn", NewInterfaces: []string{"weston_debug_stream_v1"}},
+			},
+			Events: []wayland.Method{
+				{Name: "available", Signature: "s?s"},
+			},
+		},
+		{
+			Name: "weston_debug_stream_v1",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+			Events: []wayland.Method{
+				{Name: "complete", Signature: ""},
+				{Name: "failure", Signature: "?s"},
+			},
+		},
+		{
+			Name: "weston_direct_display_v1",
+			Version: 1,
+			Requests: []wayland.Method{
+				{Name: "enable", Signature: "o"},
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+		},
+	} {
+		wlclient.RegisterInterface(iface)
+	}
+}
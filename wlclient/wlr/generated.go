@@ -0,0 +1,1630 @@
+// Package wlr contains the bindings of the protocols of the wlr protocol root, which build on the bindings of package
+// wlclient.
+package wlr
+
+import (
+	"strconv"
+	"strings"
+
+	"git.whizanth.com/go/wayland"
+	"git.whizanth.com/go/wayland/wlclient"
+)
+
+// enumEntry is a named value of an enum
+type enumEntry struct {
+	value uint32
+	name  string
+}
+
+// bitfieldString formats the flags set in value as names separated by "|", using the single-bit entries of a
+// bitfield enum and falling back to numbers for unknown flags
+func bitfieldString(value uint32, entries []enumEntry) string {
+	var names []string
+	for _, entry := range entries {
+		if entry.value == 0 {
+			if value == 0 {
+				return entry.name
+			}
+		} else if value&entry.value == entry.value {
+			names = append(names, entry.name)
+			value &^= entry.value
+		}
+	}
+
+	if value != 0 || len(names) == 0 {
+		names = append(names, strconv.FormatUint(uint64(value), 10))
+	}
+	return strings.Join(names, "|")
+}
+
+// ZwlrForeignToplevelManagerV1 is the zwlr_foreign_toplevel_manager_v1 interface: list and control opened apps
+type ZwlrForeignToplevelManagerV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrForeignToplevelManagerV1) Interface() string {
+	return "zwlr_foreign_toplevel_manager_v1"
+}
+
+// Stop sends the stop request: stop sending events
+func (object ZwlrForeignToplevelManagerV1) Stop() error {
+	if err := wlclient.Object(object).CheckRequest("stop", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// OnToplevel registers a listener for the toplevel event: a toplevel has been created
+func (object ZwlrForeignToplevelManagerV1) OnToplevel(listener func(toplevel ZwlrForeignToplevelHandleV1)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(ZwlrForeignToplevelHandleV1(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnFinished registers a listener for the finished event: the compositor has finished with the toplevel manager
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrForeignToplevelManagerV1) OnFinished(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwlrForeignToplevelHandleV1 is the zwlr_foreign_toplevel_handle_v1 interface: an opened toplevel
+type ZwlrForeignToplevelHandleV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrForeignToplevelHandleV1) Interface() string {
+	return "zwlr_foreign_toplevel_handle_v1"
+}
+
+// ZwlrForeignToplevelHandleV1State is the state enum of zwlr_foreign_toplevel_handle_v1: types of states on the
+// toplevel
+type ZwlrForeignToplevelHandleV1State uint32
+
+const (
+	// the toplevel is maximized
+	ZwlrForeignToplevelHandleV1StateMaximized ZwlrForeignToplevelHandleV1State = 0
+	// the toplevel is minimized
+	ZwlrForeignToplevelHandleV1StateMinimized ZwlrForeignToplevelHandleV1State = 1
+	// the toplevel is active
+	ZwlrForeignToplevelHandleV1StateActivated ZwlrForeignToplevelHandleV1State = 2
+	// the toplevel is fullscreen
+	//
+	// Available since version 2 of zwlr_foreign_toplevel_handle_v1.
+	ZwlrForeignToplevelHandleV1StateFullscreen ZwlrForeignToplevelHandleV1State = 3
+)
+
+func (value ZwlrForeignToplevelHandleV1State) String() string {
+	switch value {
+	case ZwlrForeignToplevelHandleV1StateMaximized:
+		return "maximized"
+	case ZwlrForeignToplevelHandleV1StateMinimized:
+		return "minimized"
+	case ZwlrForeignToplevelHandleV1StateActivated:
+		return "activated"
+	case ZwlrForeignToplevelHandleV1StateFullscreen:
+		return "fullscreen"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of zwlr_foreign_toplevel_handle_v1 value was introduced in
+func (value ZwlrForeignToplevelHandleV1State) since() uint32 {
+	switch value {
+	case ZwlrForeignToplevelHandleV1StateFullscreen:
+		return 2
+	}
+	return 1
+}
+
+// ZwlrForeignToplevelHandleV1Error is the error enum of zwlr_foreign_toplevel_handle_v1
+type ZwlrForeignToplevelHandleV1Error uint32
+
+const (
+	// the provided rectangle is invalid
+	ZwlrForeignToplevelHandleV1ErrorInvalidRectangle ZwlrForeignToplevelHandleV1Error = 0
+)
+
+func (value ZwlrForeignToplevelHandleV1Error) String() string {
+	switch value {
+	case ZwlrForeignToplevelHandleV1ErrorInvalidRectangle:
+		return "invalid_rectangle"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// SetMaximized sends the set_maximized request: requests that the toplevel be maximized
+func (object ZwlrForeignToplevelHandleV1) SetMaximized() error {
+	if err := wlclient.Object(object).CheckRequest("set_maximized", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// UnsetMaximized sends the unset_maximized request: requests that the toplevel be unmaximized
+func (object ZwlrForeignToplevelHandleV1) UnsetMaximized() error {
+	if err := wlclient.Object(object).CheckRequest("unset_maximized", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+// SetMinimized sends the set_minimized request: requests that the toplevel be minimized
+func (object ZwlrForeignToplevelHandleV1) SetMinimized() error {
+	if err := wlclient.Object(object).CheckRequest("set_minimized", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 2))
+}
+
+// UnsetMinimized sends the unset_minimized request: requests that the toplevel be unminimized
+func (object ZwlrForeignToplevelHandleV1) UnsetMinimized() error {
+	if err := wlclient.Object(object).CheckRequest("unset_minimized", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 3))
+}
+
+// Activate sends the activate request: activate the toplevel
+func (object ZwlrForeignToplevelHandleV1) Activate(seat wlclient.WlSeat) error {
+	if err := wlclient.Object(object).CheckRequest("activate", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 4, wlclient.Object(seat).Id()))
+}
+
+// Close sends the close request: request that the toplevel be closed
+func (object ZwlrForeignToplevelHandleV1) Close() error {
+	if err := wlclient.Object(object).CheckRequest("close", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 5))
+}
+
+// SetRectangle sends the set_rectangle request: the rectangle which represents the toplevel
+func (object ZwlrForeignToplevelHandleV1) SetRectangle(surface wlclient.WlSurface, x int32, y int32, width int32, height int32) error {
+	if err := wlclient.Object(object).CheckRequest("set_rectangle", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 6, wlclient.Object(surface).Id(), x, y, width, height))
+}
+
+// Destroy sends the destroy request: destroy the zwlr_foreign_toplevel_handle_v1 object
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrForeignToplevelHandleV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 7))
+}
+
+// SetFullscreen sends the set_fullscreen request: request that the toplevel be fullscreened
+//
+// Arguments:
+//   - output: nil for null
+//
+// Available since version 2 of zwlr_foreign_toplevel_handle_v1.
+func (object ZwlrForeignToplevelHandleV1) SetFullscreen(output *wlclient.WlOutput) error {
+	if err := wlclient.Object(object).CheckRequest("set_fullscreen", 2); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 8, wlclient.NullableId(output)))
+}
+
+// UnsetFullscreen sends the unset_fullscreen request: request that the toplevel be unfullscreened
+//
+// Available since version 2 of zwlr_foreign_toplevel_handle_v1.
+func (object ZwlrForeignToplevelHandleV1) UnsetFullscreen() error {
+	if err := wlclient.Object(object).CheckRequest("unset_fullscreen", 2); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 9))
+}
+
+// OnTitle registers a listener for the title event: title change
+func (object ZwlrForeignToplevelHandleV1) OnTitle(listener func(title string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnAppId registers a listener for the app_id event: app-id change
+func (object ZwlrForeignToplevelHandleV1) OnAppId(listener func(appId string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnOutputEnter registers a listener for the output_enter event: toplevel entered an output
+func (object ZwlrForeignToplevelHandleV1) OnOutputEnter(listener func(output wlclient.WlOutput)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener(wlclient.WlOutput(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnOutputLeave registers a listener for the output_leave event: toplevel left an output
+func (object ZwlrForeignToplevelHandleV1) OnOutputLeave(listener func(output wlclient.WlOutput)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 3, func(message *wayland.Message) {
+		listener(wlclient.WlOutput(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnState registers a listener for the state event: the toplevel state changed
+func (object ZwlrForeignToplevelHandleV1) OnState(listener func(state wayland.Array)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 4, func(message *wayland.Message) {
+		listener(message.ReadArray())
+	})
+}
+
+// OnDone registers a listener for the done event: all information about the toplevel has been sent
+func (object ZwlrForeignToplevelHandleV1) OnDone(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 5, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnClosed registers a listener for the closed event: this toplevel has been destroyed
+func (object ZwlrForeignToplevelHandleV1) OnClosed(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 6, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnParent registers a listener for the parent event: parent change
+//
+// Arguments:
+//   - parent: nil for null
+//
+// Available since version 3 of zwlr_foreign_toplevel_handle_v1.
+func (object ZwlrForeignToplevelHandleV1) OnParent(listener func(parent *ZwlrForeignToplevelHandleV1)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("parent", 3))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 7, func(message *wayland.Message) {
+		listener(wlclient.ResolveNullable[ZwlrForeignToplevelHandleV1](wlclient.Object(object).Client(), message.ReadUint32()))
+	})
+}
+
+// ZwlrLayerShellV1 is the zwlr_layer_shell_v1 interface: create surfaces that are layers of the desktop
+type ZwlrLayerShellV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrLayerShellV1) Interface() string {
+	return "zwlr_layer_shell_v1"
+}
+
+// ZwlrLayerShellV1Error is the error enum of zwlr_layer_shell_v1
+type ZwlrLayerShellV1Error uint32
+
+const (
+	// wl_surface has another role
+	ZwlrLayerShellV1ErrorRole ZwlrLayerShellV1Error = 0
+	// layer value is invalid
+	ZwlrLayerShellV1ErrorInvalidLayer ZwlrLayerShellV1Error = 1
+	// wl_surface has a buffer attached or committed
+	ZwlrLayerShellV1ErrorAlreadyConstructed ZwlrLayerShellV1Error = 2
+)
+
+func (value ZwlrLayerShellV1Error) String() string {
+	switch value {
+	case ZwlrLayerShellV1ErrorRole:
+		return "role"
+	case ZwlrLayerShellV1ErrorInvalidLayer:
+		return "invalid_layer"
+	case ZwlrLayerShellV1ErrorAlreadyConstructed:
+		return "already_constructed"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZwlrLayerShellV1Layer is the layer enum of zwlr_layer_shell_v1: available layers for surfaces
+type ZwlrLayerShellV1Layer uint32
+
+const (
+	ZwlrLayerShellV1LayerBackground ZwlrLayerShellV1Layer = 0
+	ZwlrLayerShellV1LayerBottom ZwlrLayerShellV1Layer = 1
+	ZwlrLayerShellV1LayerTop ZwlrLayerShellV1Layer = 2
+	ZwlrLayerShellV1LayerOverlay ZwlrLayerShellV1Layer = 3
+)
+
+func (value ZwlrLayerShellV1Layer) String() string {
+	switch value {
+	case ZwlrLayerShellV1LayerBackground:
+		return "background"
+	case ZwlrLayerShellV1LayerBottom:
+		return "bottom"
+	case ZwlrLayerShellV1LayerTop:
+		return "top"
+	case ZwlrLayerShellV1LayerOverlay:
+		return "overlay"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// GetLayerSurface sends the get_layer_surface request: create a layer_surface from a surface
+//
+// Arguments:
+//   - output: nil for null
+//   - layer: layer to add this surface to
+//   - namespace: namespace for the layer surface
+func (object ZwlrLayerShellV1) GetLayerSurface(surface wlclient.WlSurface, output *wlclient.WlOutput, layer ZwlrLayerShellV1Layer, namespace string) (ZwlrLayerSurfaceV1, error) {
+	if err := wlclient.Object(object).CheckRequest("get_layer_surface", 1); err != nil {
+		return ZwlrLayerSurfaceV1{}, err
+	}
+
+	id := ZwlrLayerSurfaceV1(wlclient.Object(object).Client().NewObject("zwlr_layer_surface_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), wlclient.Object(surface).Id(), wlclient.NullableId(output), uint32(layer), namespace)); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return ZwlrLayerSurfaceV1{}, err
+	}
+
+	return id, nil
+}
+
+// Destroy sends the destroy request: destroy the layer_shell object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of zwlr_layer_shell_v1.
+func (object ZwlrLayerShellV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 3); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+// ZwlrLayerSurfaceV1 is the zwlr_layer_surface_v1 interface: layer metadata interface
+type ZwlrLayerSurfaceV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrLayerSurfaceV1) Interface() string {
+	return "zwlr_layer_surface_v1"
+}
+
+// ZwlrLayerSurfaceV1KeyboardInteractivity is the keyboard_interactivity enum of zwlr_layer_surface_v1: types of
+// keyboard interaction possible for a layer shell surface
+type ZwlrLayerSurfaceV1KeyboardInteractivity uint32
+
+const (
+	// no keyboard focus is possible
+	ZwlrLayerSurfaceV1KeyboardInteractivityNone ZwlrLayerSurfaceV1KeyboardInteractivity = 0
+	// request exclusive keyboard focus
+	ZwlrLayerSurfaceV1KeyboardInteractivityExclusive ZwlrLayerSurfaceV1KeyboardInteractivity = 1
+	// request regular keyboard focus semantics
+	//
+	// Available since version 4 of zwlr_layer_surface_v1.
+	ZwlrLayerSurfaceV1KeyboardInteractivityOnDemand ZwlrLayerSurfaceV1KeyboardInteractivity = 2
+)
+
+func (value ZwlrLayerSurfaceV1KeyboardInteractivity) String() string {
+	switch value {
+	case ZwlrLayerSurfaceV1KeyboardInteractivityNone:
+		return "none"
+	case ZwlrLayerSurfaceV1KeyboardInteractivityExclusive:
+		return "exclusive"
+	case ZwlrLayerSurfaceV1KeyboardInteractivityOnDemand:
+		return "on_demand"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of zwlr_layer_surface_v1 value was introduced in
+func (value ZwlrLayerSurfaceV1KeyboardInteractivity) since() uint32 {
+	switch value {
+	case ZwlrLayerSurfaceV1KeyboardInteractivityOnDemand:
+		return 4
+	}
+	return 1
+}
+
+// ZwlrLayerSurfaceV1Error is the error enum of zwlr_layer_surface_v1
+type ZwlrLayerSurfaceV1Error uint32
+
+const (
+	// provided surface state is invalid
+	ZwlrLayerSurfaceV1ErrorInvalidSurfaceState ZwlrLayerSurfaceV1Error = 0
+	// size is invalid
+	ZwlrLayerSurfaceV1ErrorInvalidSize ZwlrLayerSurfaceV1Error = 1
+	// anchor bitfield is invalid
+	ZwlrLayerSurfaceV1ErrorInvalidAnchor ZwlrLayerSurfaceV1Error = 2
+	// keyboard interactivity is invalid
+	ZwlrLayerSurfaceV1ErrorInvalidKeyboardInteractivity ZwlrLayerSurfaceV1Error = 3
+	// exclusive edge is invalid given the surface anchors
+	ZwlrLayerSurfaceV1ErrorInvalidExclusiveEdge ZwlrLayerSurfaceV1Error = 4
+)
+
+func (value ZwlrLayerSurfaceV1Error) String() string {
+	switch value {
+	case ZwlrLayerSurfaceV1ErrorInvalidSurfaceState:
+		return "invalid_surface_state"
+	case ZwlrLayerSurfaceV1ErrorInvalidSize:
+		return "invalid_size"
+	case ZwlrLayerSurfaceV1ErrorInvalidAnchor:
+		return "invalid_anchor"
+	case ZwlrLayerSurfaceV1ErrorInvalidKeyboardInteractivity:
+		return "invalid_keyboard_interactivity"
+	case ZwlrLayerSurfaceV1ErrorInvalidExclusiveEdge:
+		return "invalid_exclusive_edge"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZwlrLayerSurfaceV1Anchor is the anchor enum of zwlr_layer_surface_v1
+type ZwlrLayerSurfaceV1Anchor uint32
+
+const (
+	// the top edge of the anchor rectangle
+	ZwlrLayerSurfaceV1AnchorTop ZwlrLayerSurfaceV1Anchor = 1
+	// the bottom edge of the anchor rectangle
+	ZwlrLayerSurfaceV1AnchorBottom ZwlrLayerSurfaceV1Anchor = 2
+	// the left edge of the anchor rectangle
+	ZwlrLayerSurfaceV1AnchorLeft ZwlrLayerSurfaceV1Anchor = 4
+	// the right edge of the anchor rectangle
+	ZwlrLayerSurfaceV1AnchorRight ZwlrLayerSurfaceV1Anchor = 8
+)
+
+func (value ZwlrLayerSurfaceV1Anchor) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{1, "top"},
+		{2, "bottom"},
+		{4, "left"},
+		{8, "right"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value ZwlrLayerSurfaceV1Anchor) Has(flag ZwlrLayerSurfaceV1Anchor) bool {
+	return value&flag == flag
+}
+
+// SetSize sends the set_size request: sets the size of the surface
+func (object ZwlrLayerSurfaceV1) SetSize(width uint32, height uint32) error {
+	if err := wlclient.Object(object).CheckRequest("set_size", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, width, height))
+}
+
+// SetAnchor sends the set_anchor request: configures the anchor point of the surface
+func (object ZwlrLayerSurfaceV1) SetAnchor(anchor ZwlrLayerSurfaceV1Anchor) error {
+	if err := wlclient.Object(object).CheckRequest("set_anchor", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, uint32(anchor)))
+}
+
+// SetExclusiveZone sends the set_exclusive_zone request: configures the exclusive geometry of this surface
+func (object ZwlrLayerSurfaceV1) SetExclusiveZone(zone int32) error {
+	if err := wlclient.Object(object).CheckRequest("set_exclusive_zone", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 2, zone))
+}
+
+// SetMargin sends the set_margin request: sets a margin from the anchor point
+func (object ZwlrLayerSurfaceV1) SetMargin(top int32, right int32, bottom int32, left int32) error {
+	if err := wlclient.Object(object).CheckRequest("set_margin", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 3, top, right, bottom, left))
+}
+
+// SetKeyboardInteractivity sends the set_keyboard_interactivity request: requests keyboard events
+func (object ZwlrLayerSurfaceV1) SetKeyboardInteractivity(keyboardInteractivity ZwlrLayerSurfaceV1KeyboardInteractivity) error {
+	if err := wlclient.Object(object).CheckRequest("set_keyboard_interactivity", 1); err != nil {
+		return err
+	}
+	if since := keyboardInteractivity.since(); since > 1 {
+		if err := wlclient.Object(object).CheckVersion("set_keyboard_interactivity with keyboard_interactivity " + keyboardInteractivity.String(), since); err != nil {
+			return err
+		}
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 4, uint32(keyboardInteractivity)))
+}
+
+// GetPopup sends the get_popup request: assign this layer_surface as an xdg_popup parent
+func (object ZwlrLayerSurfaceV1) GetPopup(popup wlclient.XdgPopup) error {
+	if err := wlclient.Object(object).CheckRequest("get_popup", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 5, wlclient.Object(popup).Id()))
+}
+
+// AckConfigure sends the ack_configure request: ack a configure event
+//
+// Arguments:
+//   - serial: the serial from the configure event
+func (object ZwlrLayerSurfaceV1) AckConfigure(serial uint32) error {
+	if err := wlclient.Object(object).CheckRequest("ack_configure", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 6, serial))
+}
+
+// Destroy sends the destroy request: destroy the layer_surface
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrLayerSurfaceV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 7))
+}
+
+// SetLayer sends the set_layer request: change the layer of the surface
+//
+// Arguments:
+//   - layer: layer to move this surface to
+//
+// Available since version 2 of zwlr_layer_surface_v1.
+func (object ZwlrLayerSurfaceV1) SetLayer(layer ZwlrLayerShellV1Layer) error {
+	if err := wlclient.Object(object).CheckRequest("set_layer", 2); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 8, uint32(layer)))
+}
+
+// SetExclusiveEdge sends the set_exclusive_edge request: set the edge the exclusive zone will be applied to
+//
+// Available since version 5 of zwlr_layer_surface_v1.
+func (object ZwlrLayerSurfaceV1) SetExclusiveEdge(edge ZwlrLayerSurfaceV1Anchor) error {
+	if err := wlclient.Object(object).CheckRequest("set_exclusive_edge", 5); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 9, uint32(edge)))
+}
+
+// OnConfigure registers a listener for the configure event: suggest a surface change
+func (object ZwlrLayerSurfaceV1) OnConfigure(listener func(serial uint32, width uint32, height uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnClosed registers a listener for the closed event: surface should be closed
+func (object ZwlrLayerSurfaceV1) OnClosed(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwlrOutputManagerV1 is the zwlr_output_manager_v1 interface: output device configuration manager
+type ZwlrOutputManagerV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrOutputManagerV1) Interface() string {
+	return "zwlr_output_manager_v1"
+}
+
+// CreateConfiguration sends the create_configuration request: create a new output configuration object
+func (object ZwlrOutputManagerV1) CreateConfiguration(serial uint32) (ZwlrOutputConfigurationV1, error) {
+	if err := wlclient.Object(object).CheckRequest("create_configuration", 1); err != nil {
+		return ZwlrOutputConfigurationV1{}, err
+	}
+
+	id := ZwlrOutputConfigurationV1(wlclient.Object(object).Client().NewObject("zwlr_output_configuration_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), serial)); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return ZwlrOutputConfigurationV1{}, err
+	}
+
+	return id, nil
+}
+
+// Stop sends the stop request: stop sending events
+func (object ZwlrOutputManagerV1) Stop() error {
+	if err := wlclient.Object(object).CheckRequest("stop", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+// OnHead registers a listener for the head event: introduce a new head
+func (object ZwlrOutputManagerV1) OnHead(listener func(head ZwlrOutputHeadV1)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(ZwlrOutputHeadV1(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnDone registers a listener for the done event: sent all information about current configuration
+//
+// Arguments:
+//   - serial: current configuration serial
+func (object ZwlrOutputManagerV1) OnDone(listener func(serial uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(message.ReadUint32())
+	})
+}
+
+// OnFinished registers a listener for the finished event: the compositor has finished with the manager
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrOutputManagerV1) OnFinished(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwlrOutputHeadV1 is the zwlr_output_head_v1 interface: output device
+type ZwlrOutputHeadV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrOutputHeadV1) Interface() string {
+	return "zwlr_output_head_v1"
+}
+
+// ZwlrOutputHeadV1AdaptiveSyncState is the adaptive_sync_state enum of zwlr_output_head_v1
+type ZwlrOutputHeadV1AdaptiveSyncState uint32
+
+const (
+	// adaptive sync is disabled
+	ZwlrOutputHeadV1AdaptiveSyncStateDisabled ZwlrOutputHeadV1AdaptiveSyncState = 0
+	// adaptive sync is enabled
+	ZwlrOutputHeadV1AdaptiveSyncStateEnabled ZwlrOutputHeadV1AdaptiveSyncState = 1
+)
+
+func (value ZwlrOutputHeadV1AdaptiveSyncState) String() string {
+	switch value {
+	case ZwlrOutputHeadV1AdaptiveSyncStateDisabled:
+		return "disabled"
+	case ZwlrOutputHeadV1AdaptiveSyncStateEnabled:
+		return "enabled"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// Release sends the release request: destroy the head object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of zwlr_output_head_v1.
+func (object ZwlrOutputHeadV1) Release() error {
+	if err := wlclient.Object(object).CheckRequest("release", 3); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// OnName registers a listener for the name event: head name
+func (object ZwlrOutputHeadV1) OnName(listener func(name string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnDescription registers a listener for the description event: head description
+func (object ZwlrOutputHeadV1) OnDescription(listener func(description string)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnPhysicalSize registers a listener for the physical_size event: head physical size
+//
+// Arguments:
+//   - width: width in millimeters of the output
+//   - height: height in millimeters of the output
+func (object ZwlrOutputHeadV1) OnPhysicalSize(listener func(width int32, height int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnMode registers a listener for the mode event: introduce a mode
+func (object ZwlrOutputHeadV1) OnMode(listener func(mode ZwlrOutputModeV1)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 3, func(message *wayland.Message) {
+		listener(ZwlrOutputModeV1(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnEnabled registers a listener for the enabled event: head is enabled or disabled
+//
+// Arguments:
+//   - enabled: zero if disabled, non-zero if enabled
+func (object ZwlrOutputHeadV1) OnEnabled(listener func(enabled int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 4, func(message *wayland.Message) {
+		listener(message.ReadInt32())
+	})
+}
+
+// OnCurrentMode registers a listener for the current_mode event: current mode
+func (object ZwlrOutputHeadV1) OnCurrentMode(listener func(mode ZwlrOutputModeV1)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 5, func(message *wayland.Message) {
+		listener(ZwlrOutputModeV1(wlclient.Object(object).Client().Resolve(message.ReadUint32())))
+	})
+}
+
+// OnPosition registers a listener for the position event: current position
+//
+// Arguments:
+//   - x: x position within the global compositor space
+//   - y: y position within the global compositor space
+func (object ZwlrOutputHeadV1) OnPosition(listener func(x int32, y int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 6, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnTransform registers a listener for the transform event: current transformation
+func (object ZwlrOutputHeadV1) OnTransform(listener func(transform wlclient.WlOutputTransform)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 7, func(message *wayland.Message) {
+		listener(wlclient.WlOutputTransform(message.ReadInt32()))
+	})
+}
+
+// OnScale registers a listener for the scale event: current scale
+func (object ZwlrOutputHeadV1) OnScale(listener func(scale wayland.Fixed)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 8, func(message *wayland.Message) {
+		listener(message.ReadFixed())
+	})
+}
+
+// OnFinished registers a listener for the finished event: the head has disappeared
+func (object ZwlrOutputHeadV1) OnFinished(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 9, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnMake registers a listener for the make event: head manufacturer
+//
+// Available since version 2 of zwlr_output_head_v1.
+func (object ZwlrOutputHeadV1) OnMake(listener func(make string)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("make", 2))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 10, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnModel registers a listener for the model event: head model
+//
+// Available since version 2 of zwlr_output_head_v1.
+func (object ZwlrOutputHeadV1) OnModel(listener func(model string)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("model", 2))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 11, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnSerialNumber registers a listener for the serial_number event: head serial number
+//
+// Available since version 2 of zwlr_output_head_v1.
+func (object ZwlrOutputHeadV1) OnSerialNumber(listener func(serialNumber string)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("serial_number", 2))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 12, func(message *wayland.Message) {
+		listener(message.ReadString())
+	})
+}
+
+// OnAdaptiveSync registers a listener for the adaptive_sync event: current adaptive sync state
+//
+// Available since version 4 of zwlr_output_head_v1.
+func (object ZwlrOutputHeadV1) OnAdaptiveSync(listener func(state ZwlrOutputHeadV1AdaptiveSyncState)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("adaptive_sync", 4))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 13, func(message *wayland.Message) {
+		listener(ZwlrOutputHeadV1AdaptiveSyncState(message.ReadUint32()))
+	})
+}
+
+// ZwlrOutputModeV1 is the zwlr_output_mode_v1 interface: output mode
+type ZwlrOutputModeV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrOutputModeV1) Interface() string {
+	return "zwlr_output_mode_v1"
+}
+
+// Release sends the release request: destroy the mode object
+//
+// This is a destructor, after which the object can no longer be used.
+//
+// Available since version 3 of zwlr_output_mode_v1.
+func (object ZwlrOutputModeV1) Release() error {
+	if err := wlclient.Object(object).CheckRequest("release", 3); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 0))
+}
+
+// OnSize registers a listener for the size event: mode size
+//
+// Arguments:
+//   - width: width of the mode in hardware units
+//   - height: height of the mode in hardware units
+func (object ZwlrOutputModeV1) OnSize(listener func(width int32, height int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(message.ReadInt32(), message.ReadInt32())
+	})
+}
+
+// OnRefresh registers a listener for the refresh event: mode refresh rate
+//
+// Arguments:
+//   - refresh: vertical refresh rate in mHz
+func (object ZwlrOutputModeV1) OnRefresh(listener func(refresh int32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(message.ReadInt32())
+	})
+}
+
+// OnPreferred registers a listener for the preferred event: mode is preferred
+func (object ZwlrOutputModeV1) OnPreferred(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnFinished registers a listener for the finished event: the mode has disappeared
+func (object ZwlrOutputModeV1) OnFinished(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 3, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwlrOutputConfigurationV1 is the zwlr_output_configuration_v1 interface: output configuration
+type ZwlrOutputConfigurationV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrOutputConfigurationV1) Interface() string {
+	return "zwlr_output_configuration_v1"
+}
+
+// ZwlrOutputConfigurationV1Error is the error enum of zwlr_output_configuration_v1
+type ZwlrOutputConfigurationV1Error uint32
+
+const (
+	// head has been configured twice
+	ZwlrOutputConfigurationV1ErrorAlreadyConfiguredHead ZwlrOutputConfigurationV1Error = 1
+	// head has not been configured
+	ZwlrOutputConfigurationV1ErrorUnconfiguredHead ZwlrOutputConfigurationV1Error = 2
+	// request sent after configuration has been applied or tested
+	ZwlrOutputConfigurationV1ErrorAlreadyUsed ZwlrOutputConfigurationV1Error = 3
+)
+
+func (value ZwlrOutputConfigurationV1Error) String() string {
+	switch value {
+	case ZwlrOutputConfigurationV1ErrorAlreadyConfiguredHead:
+		return "already_configured_head"
+	case ZwlrOutputConfigurationV1ErrorUnconfiguredHead:
+		return "unconfigured_head"
+	case ZwlrOutputConfigurationV1ErrorAlreadyUsed:
+		return "already_used"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// EnableHead sends the enable_head request: enable and configure a head
+//
+// Arguments:
+//   - id: a new object to configure the head
+//   - head: the head to be enabled
+func (object ZwlrOutputConfigurationV1) EnableHead(head ZwlrOutputHeadV1) (ZwlrOutputConfigurationHeadV1, error) {
+	if err := wlclient.Object(object).CheckRequest("enable_head", 1); err != nil {
+		return ZwlrOutputConfigurationHeadV1{}, err
+	}
+
+	id := ZwlrOutputConfigurationHeadV1(wlclient.Object(object).Client().NewObject("zwlr_output_configuration_head_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(id).Id(), wlclient.Object(head).Id())); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(id).Id())
+		return ZwlrOutputConfigurationHeadV1{}, err
+	}
+
+	return id, nil
+}
+
+// DisableHead sends the disable_head request: disable a head
+//
+// Arguments:
+//   - head: the head to be disabled
+func (object ZwlrOutputConfigurationV1) DisableHead(head ZwlrOutputHeadV1) error {
+	if err := wlclient.Object(object).CheckRequest("disable_head", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, wlclient.Object(head).Id()))
+}
+
+// Apply sends the apply request: apply the configuration
+func (object ZwlrOutputConfigurationV1) Apply() error {
+	if err := wlclient.Object(object).CheckRequest("apply", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 2))
+}
+
+// Test sends the test request: test the configuration
+func (object ZwlrOutputConfigurationV1) Test() error {
+	if err := wlclient.Object(object).CheckRequest("test", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 3))
+}
+
+// Destroy sends the destroy request: destroy the output configuration
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrOutputConfigurationV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 4))
+}
+
+// OnSucceeded registers a listener for the succeeded event: configuration changes succeeded
+func (object ZwlrOutputConfigurationV1) OnSucceeded(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnFailed registers a listener for the failed event: configuration changes failed
+func (object ZwlrOutputConfigurationV1) OnFailed(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnCancelled registers a listener for the cancelled event: configuration has been cancelled
+func (object ZwlrOutputConfigurationV1) OnCancelled(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// ZwlrOutputConfigurationHeadV1 is the zwlr_output_configuration_head_v1 interface: head configuration
+type ZwlrOutputConfigurationHeadV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrOutputConfigurationHeadV1) Interface() string {
+	return "zwlr_output_configuration_head_v1"
+}
+
+// ZwlrOutputConfigurationHeadV1Error is the error enum of zwlr_output_configuration_head_v1
+type ZwlrOutputConfigurationHeadV1Error uint32
+
+const (
+	// property has already been set
+	ZwlrOutputConfigurationHeadV1ErrorAlreadySet ZwlrOutputConfigurationHeadV1Error = 1
+	// mode doesn't belong to head
+	ZwlrOutputConfigurationHeadV1ErrorInvalidMode ZwlrOutputConfigurationHeadV1Error = 2
+	// mode is invalid
+	ZwlrOutputConfigurationHeadV1ErrorInvalidCustomMode ZwlrOutputConfigurationHeadV1Error = 3
+	// transform value outside enum
+	ZwlrOutputConfigurationHeadV1ErrorInvalidTransform ZwlrOutputConfigurationHeadV1Error = 4
+	// scale negative or zero
+	ZwlrOutputConfigurationHeadV1ErrorInvalidScale ZwlrOutputConfigurationHeadV1Error = 5
+	// invalid enum value used in the set_adaptive_sync request
+	//
+	// Available since version 4 of zwlr_output_configuration_head_v1.
+	ZwlrOutputConfigurationHeadV1ErrorInvalidAdaptiveSyncState ZwlrOutputConfigurationHeadV1Error = 6
+)
+
+func (value ZwlrOutputConfigurationHeadV1Error) String() string {
+	switch value {
+	case ZwlrOutputConfigurationHeadV1ErrorAlreadySet:
+		return "already_set"
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidMode:
+		return "invalid_mode"
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidCustomMode:
+		return "invalid_custom_mode"
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidTransform:
+		return "invalid_transform"
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidScale:
+		return "invalid_scale"
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidAdaptiveSyncState:
+		return "invalid_adaptive_sync_state"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// since returns the version of zwlr_output_configuration_head_v1 value was introduced in
+func (value ZwlrOutputConfigurationHeadV1Error) since() uint32 {
+	switch value {
+	case ZwlrOutputConfigurationHeadV1ErrorInvalidAdaptiveSyncState:
+		return 4
+	}
+	return 1
+}
+
+// SetMode sends the set_mode request: set the mode
+func (object ZwlrOutputConfigurationHeadV1) SetMode(mode ZwlrOutputModeV1) error {
+	if err := wlclient.Object(object).CheckRequest("set_mode", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(mode).Id()))
+}
+
+// SetCustomMode sends the set_custom_mode request: set a custom mode
+//
+// Arguments:
+//   - width: width of the mode in hardware units
+//   - height: height of the mode in hardware units
+//   - refresh: vertical refresh rate in mHz or zero
+func (object ZwlrOutputConfigurationHeadV1) SetCustomMode(width int32, height int32, refresh int32) error {
+	if err := wlclient.Object(object).CheckRequest("set_custom_mode", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, width, height, refresh))
+}
+
+// SetPosition sends the set_position request: set the position
+//
+// Arguments:
+//   - x: x position in the global compositor space
+//   - y: y position in the global compositor space
+func (object ZwlrOutputConfigurationHeadV1) SetPosition(x int32, y int32) error {
+	if err := wlclient.Object(object).CheckRequest("set_position", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 2, x, y))
+}
+
+// SetTransform sends the set_transform request: set the transform
+func (object ZwlrOutputConfigurationHeadV1) SetTransform(transform wlclient.WlOutputTransform) error {
+	if err := wlclient.Object(object).CheckRequest("set_transform", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 3, int32(transform)))
+}
+
+// SetScale sends the set_scale request: set the scale
+func (object ZwlrOutputConfigurationHeadV1) SetScale(scale wayland.Fixed) error {
+	if err := wlclient.Object(object).CheckRequest("set_scale", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 4, scale))
+}
+
+// SetAdaptiveSync sends the set_adaptive_sync request: enable/disable adaptive sync
+//
+// Available since version 4 of zwlr_output_configuration_head_v1.
+func (object ZwlrOutputConfigurationHeadV1) SetAdaptiveSync(state ZwlrOutputHeadV1AdaptiveSyncState) error {
+	if err := wlclient.Object(object).CheckRequest("set_adaptive_sync", 4); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 5, uint32(state)))
+}
+
+// ZwlrScreencopyManagerV1 is the zwlr_screencopy_manager_v1 interface: manager to inform clients and begin capturing
+type ZwlrScreencopyManagerV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrScreencopyManagerV1) Interface() string {
+	return "zwlr_screencopy_manager_v1"
+}
+
+// CaptureOutput sends the capture_output request: capture an output
+//
+// Arguments:
+//   - overlayCursor: composite cursor onto the frame
+func (object ZwlrScreencopyManagerV1) CaptureOutput(overlayCursor int32, output wlclient.WlOutput) (ZwlrScreencopyFrameV1, error) {
+	if err := wlclient.Object(object).CheckRequest("capture_output", 1); err != nil {
+		return ZwlrScreencopyFrameV1{}, err
+	}
+
+	frame := ZwlrScreencopyFrameV1(wlclient.Object(object).Client().NewObject("zwlr_screencopy_frame_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(frame).Id(), overlayCursor, wlclient.Object(output).Id())); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(frame).Id())
+		return ZwlrScreencopyFrameV1{}, err
+	}
+
+	return frame, nil
+}
+
+// CaptureOutputRegion sends the capture_output_region request: capture an output's region
+//
+// Arguments:
+//   - overlayCursor: composite cursor onto the frame
+func (object ZwlrScreencopyManagerV1) CaptureOutputRegion(overlayCursor int32, output wlclient.WlOutput, x int32, y int32, width int32, height int32) (ZwlrScreencopyFrameV1, error) {
+	if err := wlclient.Object(object).CheckRequest("capture_output_region", 1); err != nil {
+		return ZwlrScreencopyFrameV1{}, err
+	}
+
+	frame := ZwlrScreencopyFrameV1(wlclient.Object(object).Client().NewObject("zwlr_screencopy_frame_v1", wlclient.Object(object).Version()))
+
+	if err := wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 1, wlclient.Object(frame).Id(), overlayCursor, wlclient.Object(output).Id(), x, y, width, height)); err != nil {
+		wlclient.Object(object).Client().FreeObjectId(wlclient.Object(frame).Id())
+		return ZwlrScreencopyFrameV1{}, err
+	}
+
+	return frame, nil
+}
+
+// Destroy sends the destroy request: destroy the manager
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrScreencopyManagerV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 2))
+}
+
+// ZwlrScreencopyFrameV1 is the zwlr_screencopy_frame_v1 interface: a frame ready for copy
+type ZwlrScreencopyFrameV1 wlclient.Object
+
+// Interface returns the name of the interface, which is used by Bind
+func (ZwlrScreencopyFrameV1) Interface() string {
+	return "zwlr_screencopy_frame_v1"
+}
+
+// ZwlrScreencopyFrameV1Error is the error enum of zwlr_screencopy_frame_v1
+type ZwlrScreencopyFrameV1Error uint32
+
+const (
+	// the object has already been used to copy a wl_buffer
+	ZwlrScreencopyFrameV1ErrorAlreadyUsed ZwlrScreencopyFrameV1Error = 0
+	// buffer attributes are invalid
+	ZwlrScreencopyFrameV1ErrorInvalidBuffer ZwlrScreencopyFrameV1Error = 1
+)
+
+func (value ZwlrScreencopyFrameV1Error) String() string {
+	switch value {
+	case ZwlrScreencopyFrameV1ErrorAlreadyUsed:
+		return "already_used"
+	case ZwlrScreencopyFrameV1ErrorInvalidBuffer:
+		return "invalid_buffer"
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// ZwlrScreencopyFrameV1Flags is the flags enum of zwlr_screencopy_frame_v1
+type ZwlrScreencopyFrameV1Flags uint32
+
+const (
+	// contents are y-inverted
+	ZwlrScreencopyFrameV1FlagsYInvert ZwlrScreencopyFrameV1Flags = 1
+)
+
+func (value ZwlrScreencopyFrameV1Flags) String() string {
+	return bitfieldString(uint32(value), []enumEntry{
+		{1, "y_invert"},
+	})
+}
+
+// Has reports whether all flags set in flag are also set in value
+func (value ZwlrScreencopyFrameV1Flags) Has(flag ZwlrScreencopyFrameV1Flags) bool {
+	return value&flag == flag
+}
+
+// Copy sends the copy request: copy the frame
+func (object ZwlrScreencopyFrameV1) Copy(buffer wlclient.WlBuffer) error {
+	if err := wlclient.Object(object).CheckRequest("copy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 0, wlclient.Object(buffer).Id()))
+}
+
+// Destroy sends the destroy request: delete this object, used or not
+//
+// This is a destructor, after which the object can no longer be used.
+func (object ZwlrScreencopyFrameV1) Destroy() error {
+	if err := wlclient.Object(object).CheckRequest("destroy", 1); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().WriteDestructor(wlclient.Object(object).Id(), wayland.NewMessage(wlclient.Object(object).Id(), 1))
+}
+
+// CopyWithDamage sends the copy_with_damage request: copy the frame when it's damaged
+//
+// Available since version 2 of zwlr_screencopy_frame_v1.
+func (object ZwlrScreencopyFrameV1) CopyWithDamage(buffer wlclient.WlBuffer) error {
+	if err := wlclient.Object(object).CheckRequest("copy_with_damage", 2); err != nil {
+		return err
+	}
+
+	return wlclient.Object(object).Client().Write(wayland.NewMessage(wlclient.Object(object).Id(), 2, wlclient.Object(buffer).Id()))
+}
+
+// OnBuffer registers a listener for the buffer event: wl_shm buffer information
+//
+// Arguments:
+//   - format: buffer format
+//   - width: buffer width
+//   - height: buffer height
+//   - stride: buffer stride
+func (object ZwlrScreencopyFrameV1) OnBuffer(listener func(format wlclient.WlShmFormat, width uint32, height uint32, stride uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 0, func(message *wayland.Message) {
+		listener(wlclient.WlShmFormat(message.ReadUint32()), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnFlags registers a listener for the flags event: frame flags
+//
+// Arguments:
+//   - flags: frame flags
+func (object ZwlrScreencopyFrameV1) OnFlags(listener func(flags ZwlrScreencopyFrameV1Flags)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 1, func(message *wayland.Message) {
+		listener(ZwlrScreencopyFrameV1Flags(message.ReadUint32()))
+	})
+}
+
+// OnReady registers a listener for the ready event: indicates frame is available for reading
+//
+// Arguments:
+//   - tvSecHi: high 32 bits of the seconds part of the timestamp
+//   - tvSecLo: low 32 bits of the seconds part of the timestamp
+//   - tvNsec: nanoseconds part of the timestamp
+func (object ZwlrScreencopyFrameV1) OnReady(listener func(tvSecHi uint32, tvSecLo uint32, tvNsec uint32)) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 2, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnFailed registers a listener for the failed event: frame copy failed
+func (object ZwlrScreencopyFrameV1) OnFailed(listener func()) chan struct{} {
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 3, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+// OnDamage registers a listener for the damage event: carries the coordinates of the damaged region
+//
+// Arguments:
+//   - x: damaged x coordinates
+//   - y: damaged y coordinates
+//   - width: current width
+//   - height: current height
+//
+// Available since version 2 of zwlr_screencopy_frame_v1.
+func (object ZwlrScreencopyFrameV1) OnDamage(listener func(x uint32, y uint32, width uint32, height uint32)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("damage", 2))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 4, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnLinuxDmabuf registers a listener for the linux_dmabuf event: linux-dmabuf buffer information
+//
+// Arguments:
+//   - format: fourcc pixel format
+//   - width: buffer width
+//   - height: buffer height
+//
+// Available since version 3 of zwlr_screencopy_frame_v1.
+func (object ZwlrScreencopyFrameV1) OnLinuxDmabuf(listener func(format uint32, width uint32, height uint32)) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("linux_dmabuf", 3))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 5, func(message *wayland.Message) {
+		listener(message.ReadUint32(), message.ReadUint32(), message.ReadUint32())
+	})
+}
+
+// OnBufferDone registers a listener for the buffer_done event: all buffer types reported
+//
+// Available since version 3 of zwlr_screencopy_frame_v1.
+func (object ZwlrScreencopyFrameV1) OnBufferDone(listener func()) chan struct{} {
+	wlclient.Object(object).Client().Unsupported(wlclient.Object(object).CheckVersion("buffer_done", 3))
+	return wlclient.Object(object).Client().On(wlclient.Object(object).Id(), 6, func(message *wayland.Message) {
+		listener()
+	})
+}
+
+func init() {
+	for _, iface := range []*wayland.Interface{
+		{
+			Name: "zwlr_foreign_toplevel_manager_v1",
+			Version: 3,
+			Requests: []wayland.Method{
+				{Name: "stop", Signature: ""},
+			},
+			Events: []wayland.Method{
+				{Name: "toplevel", Signature: "n", NewInterfaces: []string{"zwlr_foreign_toplevel_handle_v1"}},
+				{Name: "finished", Signature: "", Destructor: true},
+			},
+		},
+		{
+			Name: "zwlr_foreign_toplevel_handle_v1",
+			Version: 3,
+			Requests: []wayland.Method{
+				{Name: "set_maximized", Signature: ""},
+				{Name: "unset_maximized", Signature: ""},
+				{Name: "set_minimized", Signature: ""},
+				{Name: "unset_minimized", Signature: ""},
+				{Name: "activate", Signature: "o"},
+				{Name: "close", Signature: ""},
+				{Name: "set_rectangle", Signature: "oiiii"},
+				{Name: "destroy", Signature: "", Destructor: true},
+				{Name: "set_fullscreen", Signature: "?o"},
+				{Name: "unset_fullscreen", Signature: ""},
+			},
+			Events: []wayland.Method{
+				{Name: "title", Signature: "s"},
+				{Name: "app_id", Signature: "s"},
+				{Name: "output_enter", Signature: "o"},
+				{Name: "output_leave", Signature: "o"},
+				{Name: "state", Signature: "a"},
+				{Name: "done", Signature: ""},
+				{Name: "closed", Signature: ""},
+				{Name: "parent", Signature: "?o"},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "state",
+					Entries: []wayland.EnumEntry{
+						{Name: "maximized", Value: 0},
+						{Name: "minimized", Value: 1},
+						{Name: "activated", Value: 2},
+						{Name: "fullscreen", Value: 3},
+					},
+				},
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "invalid_rectangle", Value: 0},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_layer_shell_v1",
+			Version: 5,
+			Requests: []wayland.Method{
+				{Name: "get_layer_surface", Signature: "no?ous", NewInterfaces: []string{"zwlr_layer_surface_v1"}},
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "role", Value: 0},
+						{Name: "invalid_layer", Value: 1},
+						{Name: "already_constructed", Value: 2},
+					},
+				},
+				{
+					Name: "layer",
+					Entries: []wayland.EnumEntry{
+						{Name: "background", Value: 0},
+						{Name: "bottom", Value: 1},
+						{Name: "top", Value: 2},
+						{Name: "overlay", Value: 3},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_layer_surface_v1",
+			Version: 5,
+			Requests: []wayland.Method{
+				{Name: "set_size", Signature: "uu"},
+				{Name: "set_anchor", Signature: "u"},
+				{Name: "set_exclusive_zone", Signature: "i"},
+				{Name: "set_margin", Signature: "iiii"},
+				{Name: "set_keyboard_interactivity", Signature: "u"},
+				{Name: "get_popup", Signature: "o"},
+				{Name: "ack_configure", Signature: "u"},
+				{Name: "destroy", Signature: "", Destructor: true},
+				{Name: "set_layer", Signature: "u"},
+				{Name: "set_exclusive_edge", Signature: "u"},
+			},
+			Events: []wayland.Method{
+				{Name: "configure", Signature: "uuu"},
+				{Name: "closed", Signature: ""},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "keyboard_interactivity",
+					Entries: []wayland.EnumEntry{
+						{Name: "none", Value: 0},
+						{Name: "exclusive", Value: 1},
+						{Name: "on_demand", Value: 2},
+					},
+				},
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "invalid_surface_state", Value: 0},
+						{Name: "invalid_size", Value: 1},
+						{Name: "invalid_anchor", Value: 2},
+						{Name: "invalid_keyboard_interactivity", Value: 3},
+						{Name: "invalid_exclusive_edge", Value: 4},
+					},
+				},
+				{
+					Name: "anchor",
+					Bitfield: true,
+					Entries: []wayland.EnumEntry{
+						{Name: "top", Value: 1},
+						{Name: "bottom", Value: 2},
+						{Name: "left", Value: 4},
+						{Name: "right", Value: 8},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_output_manager_v1",
+			Version: 4,
+			Requests: []wayland.Method{
+				{Name: "create_configuration", Signature: "nu", NewInterfaces: []string{"zwlr_output_configuration_v1"}},
+				{Name: "stop", Signature: ""},
+			},
+			Events: []wayland.Method{
+				{Name: "head", Signature: "n", NewInterfaces: []string{"zwlr_output_head_v1"}},
+				{Name: "done", Signature: "u"},
+				{Name: "finished", Signature: "", Destructor: true},
+			},
+		},
+		{
+			Name: "zwlr_output_head_v1",
+			Version: 4,
+			Requests: []wayland.Method{
+				{Name: "release", Signature: "", Destructor: true},
+			},
+			Events: []wayland.Method{
+				{Name: "name", Signature: "s"},
+				{Name: "description", Signature: "s"},
+				{Name: "physical_size", Signature: "ii"},
+				{Name: "mode", Signature: "n", NewInterfaces: []string{"zwlr_output_mode_v1"}},
+				{Name: "enabled", Signature: "i"},
+				{Name: "current_mode", Signature: "o"},
+				{Name: "position", Signature: "ii"},
+				{Name: "transform", Signature: "i"},
+				{Name: "scale", Signature: "f"},
+				{Name: "finished", Signature: ""},
+				{Name: "make", Signature: "s"},
+				{Name: "model", Signature: "s"},
+				{Name: "serial_number", Signature: "s"},
+				{Name: "adaptive_sync", Signature: "u"},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "adaptive_sync_state",
+					Entries: []wayland.EnumEntry{
+						{Name: "disabled", Value: 0},
+						{Name: "enabled", Value: 1},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_output_mode_v1",
+			Version: 3,
+			Requests: []wayland.Method{
+				{Name: "release", Signature: "", Destructor: true},
+			},
+			Events: []wayland.Method{
+				{Name: "size", Signature: "ii"},
+				{Name: "refresh", Signature: "i"},
+				{Name: "preferred", Signature: ""},
+				{Name: "finished", Signature: ""},
+			},
+		},
+		{
+			Name: "zwlr_output_configuration_v1",
+			Version: 4,
+			Requests: []wayland.Method{
+				{Name: "enable_head", Signature: "no", NewInterfaces: []string{"zwlr_output_configuration_head_v1"}},
+				{Name: "disable_head", Signature: "o"},
+				{Name: "apply", Signature: ""},
+				{Name: "test", Signature: ""},
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+			Events: []wayland.Method{
+				{Name: "succeeded", Signature: ""},
+				{Name: "failed", Signature: ""},
+				{Name: "cancelled", Signature: ""},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "already_configured_head", Value: 1},
+						{Name: "unconfigured_head", Value: 2},
+						{Name: "already_used", Value: 3},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_output_configuration_head_v1",
+			Version: 4,
+			Requests: []wayland.Method{
+				{Name: "set_mode", Signature: "o"},
+				{Name: "set_custom_mode", Signature: "iii"},
+				{Name: "set_position", Signature: "ii"},
+				{Name: "set_transform", Signature: "i"},
+				{Name: "set_scale", Signature: "f"},
+				{Name: "set_adaptive_sync", Signature: "u"},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "already_set", Value: 1},
+						{Name: "invalid_mode", Value: 2},
+						{Name: "invalid_custom_mode", Value: 3},
+						{Name: "invalid_transform", Value: 4},
+						{Name: "invalid_scale", Value: 5},
+						{Name: "invalid_adaptive_sync_state", Value: 6},
+					},
+				},
+			},
+		},
+		{
+			Name: "zwlr_screencopy_manager_v1",
+			Version: 3,
+			Requests: []wayland.Method{
+				{Name: "capture_output", Signature: "nio", NewInterfaces: []string{"zwlr_screencopy_frame_v1"}},
+				{Name: "capture_output_region", Signature: "nioiiii", NewInterfaces: []string{"zwlr_screencopy_frame_v1"}},
+				{Name: "destroy", Signature: "", Destructor: true},
+			},
+		},
+		{
+			Name: "zwlr_screencopy_frame_v1",
+			Version: 3,
+			Requests: []wayland.Method{
+				{Name: "copy", Signature: "o"},
+				{Name: "destroy", Signature: "", Destructor: true},
+				{Name: "copy_with_damage", Signature: "o"},
+			},
+			Events: []wayland.Method{
+				{Name: "buffer", Signature: "uuuu"},
+				{Name: "flags", Signature: "u"},
+				{Name: "ready", Signature: "uuu"},
+				{Name: "failed", Signature: ""},
+				{Name: "damage", Signature: "uuuu"},
+				{Name: "linux_dmabuf", Signature: "uuu"},
+				{Name: "buffer_done", Signature: ""},
+			},
+			Enums: []wayland.Enum{
+				{
+					Name: "error",
+					Entries: []wayland.EnumEntry{
+						{Name: "already_used", Value: 0},
+						{Name: "invalid_buffer", Value: 1},
+					},
+				},
+				{
+					Name: "flags",
+					Bitfield: true,
+					Entries: []wayland.EnumEntry{
+						{Name: "y_invert", Value: 1},
+					},
+				},
+			},
+		},
+	} {
+		wlclient.RegisterInterface(iface)
+	}
+}